@@ -0,0 +1,48 @@
+package cfgo
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Authenticator interface for authenticating API requests
+//
+// https://developers.cloudflare.com/fundamentals/api/how-to/make-api-calls/
+type Authenticator interface {
+	// Authenticate sets authentication headers on given request.
+	Authenticate(req *http.Request)
+}
+
+// APIKeyAuthenticator authenticates requests with account email and Global API Key (legacy).
+type APIKeyAuthenticator struct {
+	Email  string
+	APIKey string
+}
+
+// Authenticate sets `X-Auth-Email` and `X-Auth-Key` headers on given request.
+func (a APIKeyAuthenticator) Authenticate(req *http.Request) {
+	req.Header.Set(kAuthEmail, a.Email)
+	req.Header.Set(kAuthKey, a.APIKey)
+}
+
+// APITokenAuthenticator authenticates requests with a scoped API Token.
+type APITokenAuthenticator struct {
+	Token string
+}
+
+// Authenticate sets `Authorization: Bearer <token>` header on given request.
+func (a APITokenAuthenticator) Authenticate(req *http.Request) {
+	req.Header.Set(kAuthorization, fmt.Sprintf("Bearer %s", a.Token))
+}
+
+// UserServiceKeyAuthenticator authenticates requests with an Origin CA key (user service key).
+//
+// Only a few endpoints (eg. Origin CA certificates) accept this scheme.
+type UserServiceKeyAuthenticator struct {
+	ServiceKey string
+}
+
+// Authenticate sets `X-Auth-User-Service-Key` header on given request.
+func (a UserServiceKeyAuthenticator) Authenticate(req *http.Request) {
+	req.Header.Set(kAuthUserServiceKey, a.ServiceKey)
+}
@@ -0,0 +1,64 @@
+package cfgo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAuthenticators(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":[]}`))
+	}))
+	defer server.Close()
+
+	// client with deprecated fields changed after creation
+	changed := NewCloudflareClient("old@example.com", "old-api-key", WithBaseURL(server.URL))
+	changed.Email, changed.APIKey = "new@example.com", "new-api-key"
+
+	// client with deprecated fields only (no authenticator)
+	fieldsOnly := NewCloudflareClientWithAuthenticator(nil, WithBaseURL(server.URL))
+	fieldsOnly.Email, fieldsOnly.APIKey = "fields@example.com", "fields-api-key"
+
+	for name, test := range map[string]struct {
+		client   *CloudflareClient
+		expected map[string]string
+	}{
+		"api key": {
+			client:   NewCloudflareClient("user@example.com", "test-api-key", WithBaseURL(server.URL)),
+			expected: map[string]string{kAuthEmail: "user@example.com", kAuthKey: "test-api-key", kAuthorization: "", kAuthUserServiceKey: ""},
+		},
+		"api token": {
+			client:   NewCloudflareClientWithAPIToken("test-token", WithBaseURL(server.URL)),
+			expected: map[string]string{kAuthorization: "Bearer test-token", kAuthEmail: "", kAuthKey: "", kAuthUserServiceKey: ""},
+		},
+		"user service key": {
+			client:   NewCloudflareClientWithAuthenticator(UserServiceKeyAuthenticator{ServiceKey: "v1.0-service-key"}, WithBaseURL(server.URL)),
+			expected: map[string]string{kAuthUserServiceKey: "v1.0-service-key", kAuthorization: "", kAuthEmail: "", kAuthKey: ""},
+		},
+		"changed deprecated fields": {
+			client:   changed,
+			expected: map[string]string{kAuthEmail: "new@example.com", kAuthKey: "new-api-key"},
+		},
+		"deprecated fields only": {
+			client:   fieldsOnly,
+			expected: map[string]string{kAuthEmail: "fields@example.com", kAuthKey: "fields-api-key", kAuthorization: ""},
+		},
+	} {
+		if _, err := test.client.ListZones(); err != nil {
+			t.Fatalf("[%s] failed to list zones: %s", name, err)
+		}
+		for header, value := range test.expected {
+			if received.Get(header) != value {
+				t.Errorf("[%s] expected header %s: '%s', but got '%s'", name, header, value, received.Get(header))
+			}
+		}
+	}
+
+	// deprecated fields are readable
+	if client := NewCloudflareClient("user@example.com", "test-api-key"); client.Email != "user@example.com" || client.APIKey != "test-api-key" {
+		t.Errorf("expected deprecated fields to be set, but got '%s' and '%s'", client.Email, client.APIKey)
+	}
+}
@@ -0,0 +1,326 @@
+package cfgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// headers which are not recorded in cassettes
+var unrecordedHeaders = []string{
+	"Set-Cookie",
+}
+
+// Cassette struct for recorded API interactions
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction struct for a recorded pair of request and response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest struct for a recorded request (without credentials)
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   string      `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// RecordedResponse struct for a recorded response (with secrets redacted)
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// LoadCassette loads a cassette from given file.
+func LoadCassette(path string) (cassette *Cassette, err error) {
+	var bytes []byte
+	if bytes, err = os.ReadFile(path); err == nil {
+		cassette = &Cassette{}
+		if err = json.Unmarshal(bytes, cassette); err == nil {
+			return cassette, nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to load cassette: %s", err)
+}
+
+// Save saves the cassette to given file.
+func (c *Cassette) Save(path string) (err error) {
+	var bytes []byte
+	if bytes, err = json.MarshalIndent(c, "", "  "); err == nil {
+		if err = os.WriteFile(path, bytes, 0o644); err == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("failed to save cassette: %s", err)
+}
+
+// Recorder is an `http.RoundTripper` which records sanitized interactions to a cassette file.
+//
+// Credentials in request headers are stripped, and secret-bearing fields in bodies are redacted.
+// The cassette file is saved after each interaction.
+//
+//	recorder := cfgo.NewRecorder("testdata/zones.json", nil)
+//	client := cfgo.NewCloudflareClientWithAPIToken(apiToken, cfgo.WithTransport(recorder))
+type Recorder struct {
+	path      string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a new recorder which saves interactions to given file.
+//
+// Requests are sent with given transport, or with the default one if it is nil.
+func NewRecorder(path string, transport http.RoundTripper) *Recorder {
+	if transport == nil {
+		transport = newTransport()
+	}
+
+	return &Recorder{
+		path:      path,
+		transport: transport,
+	}
+}
+
+// RoundTrip sends given request and records the interaction.
+func (r *Recorder) RoundTrip(req *http.Request) (resp *http.Response, err error) {
+	var reqBody []byte
+	if reqBody, err = readRequestBody(req); err != nil {
+		return nil, err
+	}
+
+	if resp, err = r.transport.RoundTrip(req); err != nil {
+		return nil, err
+	}
+
+	var respBody []byte
+	respBody, err = io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request:  recordRequest(req, reqBody),
+		Response: recordResponse(resp, respBody),
+	})
+	if err = r.cassette.Save(r.path); err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Cassette returns a copy of the interactions recorded so far.
+func (r *Recorder) Cassette() Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return Cassette{
+		Interactions: slices.Clone(r.cassette.Interactions),
+	}
+}
+
+// Replayer is an `http.RoundTripper` which serves recorded responses from a cassette, without sending requests.
+//
+// Each request is matched with the first unused interaction of the same method, path, query, and body.
+//
+//	replayer, err := cfgo.NewReplayer("testdata/zones.json")
+//	client := cfgo.NewCloudflareClientWithAPIToken("any-token", cfgo.WithTransport(replayer))
+type Replayer struct {
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// NewReplayer returns a new replayer with the cassette loaded from given file.
+func NewReplayer(path string) (replayer *Replayer, err error) {
+	var cassette *Cassette
+	if cassette, err = LoadCassette(path); err == nil {
+		replayer = NewReplayerWithCassette(cassette)
+	}
+
+	return replayer, err
+}
+
+// NewReplayerWithCassette returns a new replayer with given cassette.
+func NewReplayerWithCassette(cassette *Cassette) *Replayer {
+	return &Replayer{
+		cassette: cassette,
+		used:     make([]bool, len(cassette.Interactions)),
+	}
+}
+
+// RoundTrip returns the recorded response for given request.
+func (r *Replayer) RoundTrip(req *http.Request) (resp *http.Response, err error) {
+	var reqBody []byte
+	if reqBody, err = readRequestBody(req); err != nil {
+		return nil, err
+	}
+	recorded := recordRequest(req, reqBody)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !interaction.Request.matches(recorded) {
+			continue
+		}
+		r.used[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Headers.Clone(),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction for request: %s %s", req.Method, req.URL.RequestURI())
+}
+
+// Remaining returns the number of interactions which are not replayed yet.
+func (r *Replayer) Remaining() (remaining int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, used := range r.used {
+		if !used {
+			remaining++
+		}
+	}
+
+	return remaining
+}
+
+// checks if given request matches this one (headers are not compared)
+//
+// multipart/form-data bodies are compared by their fields and file contents, as their boundaries are random.
+func (r RecordedRequest) matches(other RecordedRequest) bool {
+	if r.Method != other.Method || r.Path != other.Path || r.Query != other.Query {
+		return false
+	}
+
+	if parts, ok := r.multipartForm(); ok {
+		otherParts, ok := other.multipartForm()
+		return ok && maps.EqualFunc(parts, otherParts, slices.Equal)
+	}
+
+	return r.Body == other.Body
+}
+
+// parses the multipart/form-data body of this request into a map of part names and their values (or file contents)
+//
+// returns false if the body is not multipart/form-data or cannot be parsed.
+func (r RecordedRequest) multipartForm() (parts map[string][]string, ok bool) {
+	mediaType, params, err := mime.ParseMediaType(r.Headers.Get(kContentType))
+	if err != nil || mediaType != "multipart/form-data" || params["boundary"] == "" {
+		return nil, false
+	}
+
+	parts = map[string][]string{}
+	reader := multipart.NewReader(strings.NewReader(r.Body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return parts, true
+		} else if err != nil {
+			return nil, false
+		}
+
+		value, err := io.ReadAll(part)
+		_ = part.Close()
+		if err != nil {
+			return nil, false
+		}
+		key := part.FormName()
+		if filename := part.FileName(); filename != "" {
+			key += "; filename=" + filename
+		}
+		parts[key] = append(parts[key], string(value))
+	}
+}
+
+// reads the body of given request, without consuming it
+func readRequestBody(req *http.Request) (body []byte, err error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		var reader io.ReadCloser
+		if reader, err = req.GetBody(); err == nil {
+			defer func() { _ = reader.Close() }()
+			body, err = io.ReadAll(reader)
+		}
+	} else {
+		if body, err = io.ReadAll(req.Body); err == nil {
+			_ = req.Body.Close()
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %s", err)
+	}
+
+	return body, nil
+}
+
+// returns a sanitized record of given request
+func recordRequest(req *http.Request, body []byte) RecordedRequest {
+	headers := req.Header.Clone()
+	for _, header := range sensitiveHeaders {
+		headers.Del(header)
+	}
+
+	return RecordedRequest{
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Query:   req.URL.RawQuery,
+		Headers: headers,
+		Body:    string(redactBody(body)),
+	}
+}
+
+// returns a sanitized record of given response
+func recordResponse(resp *http.Response, body []byte) RecordedResponse {
+	headers := resp.Header.Clone()
+	for _, header := range unrecordedHeaders {
+		headers.Del(header)
+	}
+
+	return RecordedResponse{
+		StatusCode: resp.StatusCode,
+		Headers:    headers,
+		Body:       string(redactBody(body)),
+	}
+}
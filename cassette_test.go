@@ -0,0 +1,75 @@
+package cfgo
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/meinside/cloudflare-go/cfgotest"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	// record
+	server := cfgotest.NewServer()
+	zoneID := server.AddZone("example.com")
+
+	recorder := NewRecorder(path, nil)
+	client := NewCloudflareClientWithAPIToken("secret-api-token", WithBaseURL(server.BaseURL()), WithTransport(recorder))
+
+	if _, err := client.ListZones(); err != nil {
+		t.Fatalf("failed to list zones: %s", err)
+	}
+	created, err := client.CreateDNSRecord(zoneID, NewDNSRecordTXT("recorded", "hello"))
+	if err != nil {
+		t.Fatalf("failed to create dns record: %s", err)
+	}
+	server.Close()
+
+	if len(recorder.Cassette().Interactions) != 2 {
+		t.Errorf("expected 2 recorded interactions, but got %d", len(recorder.Cassette().Interactions))
+	}
+
+	// credentials should be stripped
+	if bytes, err := os.ReadFile(path); err == nil {
+		if strings.Contains(string(bytes), "secret-api-token") {
+			t.Errorf("credentials should not be recorded")
+		}
+	} else {
+		t.Fatalf("failed to read cassette: %s", err)
+	}
+
+	// replay (without the server)
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("failed to create replayer: %s", err)
+	}
+	client = NewCloudflareClientWithAPIToken("any-token", WithBaseURL(server.BaseURL()), WithTransport(replayer))
+
+	if zones, err := client.ListZones(); err == nil {
+		if len(zones.Result) != 1 || zones.Result[0].ID != zoneID {
+			t.Errorf("unexpected replayed zones: %+v", zones.Result)
+		}
+	} else {
+		t.Errorf("failed to replay listing zones: %s", err)
+	}
+	if replayed, err := client.CreateDNSRecord(zoneID, NewDNSRecordTXT("recorded", "hello")); err == nil {
+		createdID, _ := created.Result.StringFor("id")
+		replayedID, _ := replayed.Result.StringFor("id")
+		if replayedID != createdID {
+			t.Errorf("expected record id '%s', but got '%s'", createdID, replayedID)
+		}
+	} else {
+		t.Errorf("failed to replay creating a dns record: %s", err)
+	}
+	if replayer.Remaining() != 0 {
+		t.Errorf("all interactions should be replayed, but %d remain", replayer.Remaining())
+	}
+
+	// unrecorded requests fail
+	if _, err := client.DeleteDNSRecord(zoneID, "unrecorded"); err == nil {
+		t.Errorf("should fail with an unrecorded request")
+	}
+}
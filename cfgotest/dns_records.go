@@ -0,0 +1,607 @@
+package cfgotest
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// record types which have `content`
+var contentTypes = []string{"A", "AAAA", "CNAME", "MX", "NS", "PTR", "TXT"}
+
+// record types which have `data`
+var dataTypes = []string{"CAA", "CERT", "DNSKEY", "DS", "HTTPS", "LOC", "NAPTR", "SMIMEA", "SRV", "SSHFP", "SVCB", "TLSA", "URI"}
+
+// record types which can be proxied
+var proxiableTypes = []string{"A", "AAAA", "CNAME"}
+
+// AddDNSRecord adds given DNS record to the zone without validation, and returns its identifier.
+//
+// Missing values (eg. `id`, `zone_id`, `ttl`) are filled automatically.
+func (s *Server) AddDNSRecord(zoneID string, record map[string]any) (recordID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zone := s.zone(zoneID)
+	if zone == nil {
+		panic(fmt.Sprintf("no such zone: %s", zoneID))
+	}
+
+	added := fillRecord(zone, deepCopy(record))
+	s.records[zoneID] = append(s.records[zoneID], added)
+
+	return added["id"].(string)
+}
+
+// DNSRecords returns (copies of) all DNS records of the zone.
+func (s *Server) DNSRecords(zoneID string) []map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return deepCopy(s.records[zoneID])
+}
+
+// set of DNS records in a zone, for applying changes
+type recordSet struct {
+	zone    map[string]any
+	records []map[string]any
+}
+
+// returns the record set of given zone (should be called with the lock held)
+func (s *Server) recordSet(r *http.Request) (*recordSet, *apiError) {
+	zoneID := r.PathValue("zone_id")
+
+	zone := s.zone(zoneID)
+	if zone == nil {
+		return nil, zoneNotFound(r)
+	}
+
+	return &recordSet{
+		zone:    zone,
+		records: s.records[zoneID],
+	}, nil
+}
+
+// returns the index of the record with given identifier
+func (rs *recordSet) index(recordID string) (int, *apiError) {
+	for i, record := range rs.records {
+		if record["id"] == recordID {
+			return i, nil
+		}
+	}
+
+	return -1, &apiError{http.StatusNotFound, errCodeRecordNotFound, "Record does not exist."}
+}
+
+// creates a record with given input
+func (rs *recordSet) create(input map[string]any) (map[string]any, *apiError) {
+	record, err := normalizeRecord(rs.zone, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := rs.checkConflicts(record, ""); err != nil {
+		return nil, err
+	}
+
+	// identifiers and timestamps are generated
+	for _, key := range []string{"id", "zone_id", "zone_name", "created_on", "modified_on"} {
+		delete(record, key)
+	}
+	record = fillRecord(rs.zone, record)
+	rs.records = append(rs.records, record)
+
+	return record, nil
+}
+
+// overwrites the record with given identifier
+func (rs *recordSet) update(recordID string, input map[string]any) (map[string]any, *apiError) {
+	i, err := rs.index(recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.replace(i, input)
+}
+
+// updates given fields of the record with given identifier
+func (rs *recordSet) patch(recordID string, input map[string]any) (map[string]any, *apiError) {
+	i, err := rs.index(recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.replace(i, merge(deepCopy(rs.records[i]), input))
+}
+
+// replaces the record at given index with given input
+func (rs *recordSet) replace(i int, input map[string]any) (map[string]any, *apiError) {
+	existing := rs.records[i]
+
+	record, err := normalizeRecord(rs.zone, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := rs.checkConflicts(record, existing["id"].(string)); err != nil {
+		return nil, err
+	}
+
+	record["id"] = existing["id"]
+	record["created_on"] = existing["created_on"]
+	record["modified_on"] = now()
+	record = fillRecord(rs.zone, record)
+
+	rs.records = slices.Clone(rs.records)
+	rs.records[i] = record
+
+	return record, nil
+}
+
+// deletes the record with given identifier
+func (rs *recordSet) delete(recordID string) (map[string]any, *apiError) {
+	i, err := rs.index(recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := rs.records[i]
+	rs.records = slices.Delete(slices.Clone(rs.records), i, i+1)
+
+	return deleted, nil
+}
+
+// checks if given record conflicts with existing records (except the one with `exceptID`)
+func (rs *recordSet) checkConflicts(record map[string]any, exceptID string) *apiError {
+	for _, existing := range rs.records {
+		if existing["id"] == exceptID || existing["name"] != record["name"] {
+			continue
+		}
+
+		if existing["type"] == record["type"] &&
+			existing["content"] == record["content"] &&
+			jsonEqual(existing["data"], record["data"]) {
+			return &apiError{http.StatusBadRequest, errCodeIdenticalRecordExist, "An identical record already exists."}
+		}
+		if existing["type"] == "CNAME" || record["type"] == "CNAME" {
+			return &apiError{http.StatusBadRequest, errCodeRecordAlreadyExists, "A CNAME record cannot coexist with other records of the same name."}
+		}
+	}
+
+	return nil
+}
+
+// validates and normalizes given input into a record
+func normalizeRecord(zone map[string]any, input map[string]any) (map[string]any, *apiError) {
+	record := deepCopy(input)
+
+	// type
+	typ3, _ := record["type"].(string)
+	typ3 = strings.ToUpper(typ3)
+	if !slices.Contains(contentTypes, typ3) && !slices.Contains(dataTypes, typ3) {
+		return nil, &apiError{http.StatusBadRequest, errCodeInvalidType, fmt.Sprintf("Invalid DNS record type: '%s'", typ3)}
+	}
+	record["type"] = typ3
+
+	// name
+	name, _ := record["name"].(string)
+	if name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), "."); name == "" {
+		return nil, &apiError{http.StatusBadRequest, errCodeInvalidDNSName, "DNS name is invalid."}
+	}
+	zoneName := zone["name"].(string)
+	if name == "@" {
+		name = zoneName
+	} else if name != zoneName && !strings.HasSuffix(name, "."+zoneName) {
+		name = name + "." + zoneName
+	}
+	record["name"] = name
+
+	// content or data
+	content, _ := record["content"].(string)
+	if slices.Contains(contentTypes, typ3) {
+		if content == "" {
+			return nil, &apiError{http.StatusBadRequest, errCodeInvalidContent, fmt.Sprintf("Content for %s record is invalid.", typ3)}
+		}
+
+		switch typ3 {
+		case "A":
+			if addr, err := netip.ParseAddr(content); err != nil || !addr.Is4() {
+				return nil, &apiError{http.StatusBadRequest, errCodeInvalidIPContent, "Content for A record must be a valid IPv4 address."}
+			}
+		case "AAAA":
+			if addr, err := netip.ParseAddr(content); err != nil || !addr.Is6() {
+				return nil, &apiError{http.StatusBadRequest, errCodeInvalidIPContent, "Content for AAAA record must be a valid IPv6 address."}
+			}
+		}
+	} else {
+		if _, ok := record["data"].(map[string]any); !ok && content == "" {
+			return nil, &apiError{http.StatusBadRequest, errCodeMissingData, fmt.Sprintf("Data for %s record is missing.", typ3)}
+		}
+	}
+
+	// ttl
+	if ttl, exists := record["ttl"]; exists {
+		if f, ok := ttl.(float64); !ok || (f != 1 && (f < 60 || f > 86400)) {
+			return nil, &apiError{http.StatusBadRequest, errCodeInvalidTTL, "TTL must be between 60 and 86400 seconds, or 1 for Automatic."}
+		}
+	}
+
+	// proxied
+	if proxied, _ := record["proxied"].(bool); proxied && !slices.Contains(proxiableTypes, typ3) {
+		return nil, &apiError{http.StatusBadRequest, errCodeNotProxiable, fmt.Sprintf("%s records cannot be proxied.", typ3)}
+	}
+
+	return record, nil
+}
+
+// fills missing values of given record
+func fillRecord(zone map[string]any, record map[string]any) map[string]any {
+	timestamp := now()
+
+	defaults := map[string]any{
+		"id":          newID(),
+		"zone_id":     zone["id"],
+		"zone_name":   zone["name"],
+		"ttl":         float64(1),
+		"comment":     nil,
+		"tags":        []any{},
+		"settings":    map[string]any{},
+		"meta":        map[string]any{"auto_added": false, "source": "primary"},
+		"locked":      false,
+		"proxiable":   false,
+		"created_on":  timestamp,
+		"modified_on": timestamp,
+	}
+	typ3, _ := record["type"].(string)
+	if slices.Contains(proxiableTypes, typ3) {
+		defaults["proxiable"] = true
+		defaults["proxied"] = false
+	}
+
+	for k, v := range defaults {
+		if existing, exists := record[k]; !exists || (existing == nil && v != nil) {
+			record[k] = v
+		}
+	}
+
+	return record
+}
+
+// merges given patch into the record, recursively for nested objects
+func merge(record, patch map[string]any) map[string]any {
+	for k, v := range patch {
+		if nested, ok := v.(map[string]any); ok {
+			if existing, ok := record[k].(map[string]any); ok {
+				record[k] = merge(existing, nested)
+				continue
+			}
+		}
+		record[k] = v
+	}
+
+	return record
+}
+
+// checks if given values are equal in JSON
+func jsonEqual(a, b any) bool {
+	encodedA, _ := json.Marshal(a)
+	encodedB, _ := json.Marshal(b)
+
+	return string(encodedA) == string(encodedB)
+}
+
+// decode the request body into a map
+func decodeBody(r *http.Request, v any) *apiError {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return &apiError{http.StatusBadRequest, errCodeBadRequest, fmt.Sprintf("Malformed JSON in request body: %s", err)}
+	}
+
+	return nil
+}
+
+// GET /zones/{zone_id}/dns_records
+func (s *Server) listDNSRecords(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rs, err := s.recordSet(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	query := r.URL.Query()
+
+	filtered := []map[string]any{}
+	for _, record := range rs.records {
+		if matchesRecord(record, query) {
+			filtered = append(filtered, record)
+		}
+	}
+	sortRecords(filtered, query.Get("order"), query.Get("direction"))
+
+	page, perPage := pageParams(r, defaultDNSRecordsPerPage, maxDNSRecordsPerPage)
+	paged, info := paginate(filtered, page, perPage)
+
+	writeResult(w, deepCopy(paged), info)
+}
+
+// POST /zones/{zone_id}/dns_records
+func (s *Server) createDNSRecord(w http.ResponseWriter, r *http.Request) {
+	s.apply(w, r, func(rs *recordSet, input map[string]any) (map[string]any, *apiError) {
+		return rs.create(input)
+	})
+}
+
+// GET /zones/{zone_id}/dns_records/{record_id}
+func (s *Server) getDNSRecord(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rs, err := s.recordSet(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	i, err := rs.index(r.PathValue("record_id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResult(w, deepCopy(rs.records[i]), nil)
+}
+
+// PUT /zones/{zone_id}/dns_records/{record_id}
+func (s *Server) updateDNSRecord(w http.ResponseWriter, r *http.Request) {
+	s.apply(w, r, func(rs *recordSet, input map[string]any) (map[string]any, *apiError) {
+		return rs.update(r.PathValue("record_id"), input)
+	})
+}
+
+// PATCH /zones/{zone_id}/dns_records/{record_id}
+func (s *Server) patchDNSRecord(w http.ResponseWriter, r *http.Request) {
+	s.apply(w, r, func(rs *recordSet, input map[string]any) (map[string]any, *apiError) {
+		return rs.patch(r.PathValue("record_id"), input)
+	})
+}
+
+// DELETE /zones/{zone_id}/dns_records/{record_id}
+func (s *Server) deleteDNSRecord(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rs, err := s.recordSet(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	deleted, err := rs.delete(r.PathValue("record_id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.records[r.PathValue("zone_id")] = rs.records
+
+	writeResult(w, map[string]any{"id": deleted["id"]}, nil)
+}
+
+// POST /zones/{zone_id}/dns_records/batch
+func (s *Server) batchDNSRecords(w http.ResponseWriter, r *http.Request) {
+	var batch struct {
+		Deletes []map[string]any `json:"deletes"`
+		Patches []map[string]any `json:"patches"`
+		Puts    []map[string]any `json:"puts"`
+		Posts   []map[string]any `json:"posts"`
+	}
+	if err := decodeBody(r, &batch); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rs, err := s.recordSet(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	result := map[string][]map[string]any{
+		"deletes": {},
+		"patches": {},
+		"puts":    {},
+		"posts":   {},
+	}
+
+	// apply all operations in order, or nothing
+	for _, op := range []struct {
+		key   string
+		items []map[string]any
+		apply func(map[string]any) (map[string]any, *apiError)
+	}{
+		{"deletes", batch.Deletes, func(item map[string]any) (map[string]any, *apiError) {
+			id, _ := item["id"].(string)
+			return rs.delete(id)
+		}},
+		{"patches", batch.Patches, func(item map[string]any) (map[string]any, *apiError) {
+			id, _ := item["id"].(string)
+			return rs.patch(id, withoutID(item))
+		}},
+		{"puts", batch.Puts, func(item map[string]any) (map[string]any, *apiError) {
+			id, _ := item["id"].(string)
+			return rs.update(id, withoutID(item))
+		}},
+		{"posts", batch.Posts, rs.create},
+	} {
+		for _, item := range op.items {
+			applied, err := op.apply(item)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			result[op.key] = append(result[op.key], applied)
+		}
+	}
+	s.records[r.PathValue("zone_id")] = rs.records
+
+	writeResult(w, deepCopy(result), nil)
+}
+
+// apply a change with the request body, and write the changed record
+func (s *Server) apply(w http.ResponseWriter, r *http.Request, change func(*recordSet, map[string]any) (map[string]any, *apiError)) {
+	var input map[string]any
+	if err := decodeBody(r, &input); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rs, err := s.recordSet(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	changed, err := change(rs, input)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.records[r.PathValue("zone_id")] = rs.records
+
+	writeResult(w, deepCopy(changed), nil)
+}
+
+// returns a copy of given item without `id`
+func withoutID(item map[string]any) map[string]any {
+	copied := maps.Clone(item)
+	delete(copied, "id")
+
+	return copied
+}
+
+// checks if given record matches the filters in given query
+func matchesRecord(record map[string]any, query url.Values) bool {
+	conditions := []bool{}
+
+	// name, content, comment
+	for _, field := range []string{"name", "content", "comment"} {
+		value, _ := record[field].(string)
+		for _, op := range []string{"", ".exact", ".contains", ".startswith", ".endswith"} {
+			if query.Has(field + op) {
+				conditions = append(conditions, matchOperator(value, op, query.Get(field+op)))
+			}
+		}
+	}
+	if query.Has("comment.present") {
+		conditions = append(conditions, record["comment"] != nil && record["comment"] != "")
+	}
+	if query.Has("comment.absent") {
+		conditions = append(conditions, record["comment"] == nil || record["comment"] == "")
+	}
+
+	// type
+	if types, exists := query["type"]; exists {
+		typ3, _ := record["type"].(string)
+		conditions = append(conditions, slices.Contains(types, typ3))
+	}
+
+	// proxied
+	if query.Has("proxied") {
+		proxied, _ := record["proxied"].(bool)
+		conditions = append(conditions, fmt.Sprintf("%t", proxied) == query.Get("proxied"))
+	}
+
+	// search
+	if term := strings.ToLower(query.Get("search")); term != "" {
+		encoded, _ := json.Marshal([]any{record["name"], record["content"], record["comment"], record["tags"]})
+		conditions = append(conditions, strings.Contains(strings.ToLower(string(encoded)), term))
+	}
+
+	// tags (combined with `tag_match`, then as a single condition)
+	tagConditions := []bool{}
+	tags := []string{}
+	if values, ok := record["tags"].([]any); ok {
+		for _, v := range values {
+			if tag, ok := v.(string); ok {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	for _, op := range []string{"", ".exact", ".contains", ".startswith", ".endswith"} {
+		for _, filter := range query["tag"+op] {
+			filterName, filterValue, _ := strings.Cut(filter, ":")
+			tagConditions = append(tagConditions, slices.ContainsFunc(tags, func(tag string) bool {
+				name, value, _ := strings.Cut(tag, ":")
+				return name == filterName && matchOperator(value, op, filterValue)
+			}))
+		}
+	}
+	for _, filterName := range query["tag.present"] {
+		tagConditions = append(tagConditions, slices.ContainsFunc(tags, func(tag string) bool {
+			name, _, _ := strings.Cut(tag, ":")
+			return name == filterName
+		}))
+	}
+	for _, filterName := range query["tag.absent"] {
+		tagConditions = append(tagConditions, !slices.ContainsFunc(tags, func(tag string) bool {
+			name, _, _ := strings.Cut(tag, ":")
+			return name == filterName
+		}))
+	}
+	if len(tagConditions) > 0 {
+		conditions = append(conditions, matches(tagConditions, query.Get("tag_match") == "any"))
+	}
+
+	return matches(conditions, query.Get("match") == "any")
+}
+
+// matches given value with a string operator (eg. `.contains`), case-insensitively
+func matchOperator(value, op, operand string) bool {
+	value, operand = strings.ToLower(value), strings.ToLower(operand)
+
+	switch op {
+	case ".contains":
+		return strings.Contains(value, operand)
+	case ".startswith":
+		return strings.HasPrefix(value, operand)
+	case ".endswith":
+		return strings.HasSuffix(value, operand)
+	}
+
+	return value == operand
+}
+
+// sort records with given order and direction
+func sortRecords(records []map[string]any, order, direction string) {
+	if order == "" {
+		return
+	}
+
+	slices.SortStableFunc(records, func(a, b map[string]any) int {
+		var compared int
+		switch order {
+		case "ttl":
+			ttlA, _ := a["ttl"].(float64)
+			ttlB, _ := b["ttl"].(float64)
+			compared = cmp.Compare(ttlA, ttlB)
+		default:
+			compared = cmp.Compare(fmt.Sprint(a[order]), fmt.Sprint(b[order]))
+		}
+
+		if direction == "desc" {
+			return -compared
+		}
+		return compared
+	})
+}
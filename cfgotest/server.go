@@ -0,0 +1,263 @@
+// Package cfgotest provides an in-memory fake of Cloudflare API for hermetic tests.
+//
+// It implements zones (including creation, edit, and deletion) and dns_records endpoints with filters, pagination, validation errors,
+// and failure (eg. rate limit) injection:
+//
+//	server := cfgotest.NewServer()
+//	defer server.Close()
+//
+//	zoneID := server.AddZone("example.com")
+//	client := cfgo.NewCloudflareClientWithAPIToken("any-token", cfgo.WithBaseURL(server.BaseURL()))
+package cfgotest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// path prefix of API endpoints, same as the real one
+	basePath = "/client/v4"
+
+	defaultZonesPerPage      = 20
+	maxZonesPerPage          = 50
+	defaultDNSRecordsPerPage = 100
+	maxDNSRecordsPerPage     = 5000000
+
+	// error codes of Cloudflare API
+	errCodeRateLimited          = 971
+	errCodeInvalidRoute         = 7003
+	errCodeBadRequest           = 1004
+	errCodeZoneAlreadyExists    = 1061
+	errCodeInvalidZoneName      = 1097
+	errCodeInvalidDNSName       = 9000
+	errCodeNotProxiable         = 9004
+	errCodeInvalidIPContent     = 9005
+	errCodeInvalidContent       = 9007
+	errCodeInvalidType          = 9020
+	errCodeInvalidTTL           = 9021
+	errCodeMissingData          = 9101
+	errCodeMissingAuthHeaders   = 9106
+	errCodeRecordNotFound       = 81044
+	errCodeRecordAlreadyExists  = 81057
+	errCodeIdenticalRecordExist = 81058
+)
+
+// Server is an in-memory fake of Cloudflare API, backed by an `httptest.Server`.
+//
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+
+	zones   []map[string]any            // zones in insertion order
+	records map[string][]map[string]any // DNS records by zone identifier, in insertion order
+
+	failures []failure // injected failures
+	requests int       // number of handled requests
+}
+
+// injected failure
+type failure struct {
+	statusCode int
+	code       int
+	message    string
+}
+
+// apiError for errors in responses
+type apiError struct {
+	statusCode int
+	code       int
+	message    string
+}
+
+// NewServer starts and returns a new fake server.
+//
+// It should be closed with `Close` after use.
+func NewServer() *Server {
+	s := &Server{
+		records: map[string][]map[string]any{},
+	}
+
+	mux := http.NewServeMux()
+
+	// zones
+	mux.HandleFunc("GET "+basePath+"/zones", s.listZones)
+	mux.HandleFunc("POST "+basePath+"/zones", s.createZone)
+	mux.HandleFunc("GET "+basePath+"/zones/{zone_id}", s.getZone)
+	mux.HandleFunc("PATCH "+basePath+"/zones/{zone_id}", s.editZone)
+	mux.HandleFunc("DELETE "+basePath+"/zones/{zone_id}", s.deleteZone)
+	mux.HandleFunc("PUT "+basePath+"/zones/{zone_id}/activation_check", s.triggerActivationCheck)
+
+	// dns records
+	mux.HandleFunc("GET "+basePath+"/zones/{zone_id}/dns_records", s.listDNSRecords)
+	mux.HandleFunc("POST "+basePath+"/zones/{zone_id}/dns_records", s.createDNSRecord)
+	mux.HandleFunc("POST "+basePath+"/zones/{zone_id}/dns_records/batch", s.batchDNSRecords)
+	mux.HandleFunc("GET "+basePath+"/zones/{zone_id}/dns_records/export", s.exportDNSRecords)
+	mux.HandleFunc("POST "+basePath+"/zones/{zone_id}/dns_records/import", s.importDNSRecords)
+	mux.HandleFunc("GET "+basePath+"/zones/{zone_id}/dns_records/{record_id}", s.getDNSRecord)
+	mux.HandleFunc("PUT "+basePath+"/zones/{zone_id}/dns_records/{record_id}", s.updateDNSRecord)
+	mux.HandleFunc("PATCH "+basePath+"/zones/{zone_id}/dns_records/{record_id}", s.patchDNSRecord)
+	mux.HandleFunc("DELETE "+basePath+"/zones/{zone_id}/dns_records/{record_id}", s.deleteDNSRecord)
+
+	s.Server = httptest.NewServer(s.intercept(mux))
+
+	return s
+}
+
+// BaseURL returns the base URL of API endpoints, for `cfgo.WithBaseURL` option.
+func (s *Server) BaseURL() string {
+	return s.URL + basePath
+}
+
+// RateLimitNext makes the next `n` requests fail with HTTP 429 (Too Many Requests).
+func (s *Server) RateLimitNext(n int) {
+	s.FailNext(n, http.StatusTooManyRequests, errCodeRateLimited, "Please wait and consider throttling your request speed")
+}
+
+// FailNext makes the next `n` requests fail with given HTTP status code and Cloudflare error.
+func (s *Server) FailNext(n int, statusCode, code int, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for range n {
+		s.failures = append(s.failures, failure{
+			statusCode: statusCode,
+			code:       code,
+			message:    message,
+		})
+	}
+}
+
+// Requests returns the number of requests handled so far (including failed ones).
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+// intercept requests for counting, authentication, and injected failures
+func (s *Server) intercept(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests++
+		var injected *failure
+		if len(s.failures) > 0 {
+			injected = &s.failures[0]
+			s.failures = s.failures[1:]
+		}
+		s.mu.Unlock()
+
+		if injected != nil {
+			if injected.statusCode == http.StatusTooManyRequests {
+				w.Header().Set("Retry-After", "0")
+			}
+			writeError(w, &apiError{injected.statusCode, injected.code, injected.message})
+			return
+		}
+
+		// any credential is accepted, but one should exist
+		if r.Header.Get("Authorization") == "" &&
+			r.Header.Get("X-Auth-Key") == "" &&
+			r.Header.Get("X-Auth-User-Service-Key") == "" {
+			writeError(w, &apiError{http.StatusBadRequest, errCodeMissingAuthHeaders, "Missing X-Auth-Key, X-Auth-Email or Authorization headers"})
+			return
+		}
+
+		w.Header().Set("Cf-Ray", newID()[:16]+"-TST")
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// returns a new random identifier
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// returns the current time in the format of Cloudflare API
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339Nano)
+}
+
+// returns a deep copy of given JSON-compatible value
+func deepCopy[T any](v T) (copied T) {
+	bytes, _ := json.Marshal(v)
+	_ = json.Unmarshal(bytes, &copied)
+
+	return copied
+}
+
+// write a successful response with given result
+func writeResult(w http.ResponseWriter, result any, resultInfo map[string]any) {
+	response := map[string]any{
+		"success":  true,
+		"errors":   []any{},
+		"messages": []any{},
+		"result":   result,
+	}
+	if resultInfo != nil {
+		response["result_info"] = resultInfo
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// write an error response
+func writeError(w http.ResponseWriter, err *apiError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(err.statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"success": false,
+		"errors": []any{
+			map[string]any{
+				"code":    err.code,
+				"message": err.message,
+			},
+		},
+		"messages": []any{},
+		"result":   nil,
+	})
+}
+
+// read page parameters from given request
+func pageParams(r *http.Request, defaultPerPage, maxPerPage int) (page, perPage int) {
+	page, _ = strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	perPage, _ = strconv.Atoi(r.URL.Query().Get("per_page"))
+	if perPage < 1 {
+		perPage = defaultPerPage
+	}
+
+	return page, min(perPage, maxPerPage)
+}
+
+// paginate given items, returning items of the page and result info
+func paginate(items []map[string]any, page, perPage int) ([]map[string]any, map[string]any) {
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+	paged := slices.Clone(items[start:end])
+
+	return paged, map[string]any{
+		"page":        page,
+		"per_page":    perPage,
+		"count":       len(paged),
+		"total_count": len(items),
+		"total_pages": (len(items) + perPage - 1) / perPage,
+	}
+}
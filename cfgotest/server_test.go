@@ -0,0 +1,203 @@
+package cfgotest_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	cfgo "github.com/meinside/cloudflare-go"
+	"github.com/meinside/cloudflare-go/cfgotest"
+)
+
+// returns a new fake server with a zone, and a client for it
+func newTestClient() (server *cfgotest.Server, zoneID string, client *cfgo.CloudflareClient) {
+	server = cfgotest.NewServer()
+	zoneID = server.AddZone("example.com")
+	client = cfgo.NewCloudflareClientWithAPIToken("test-token", cfgo.WithBaseURL(server.BaseURL()))
+
+	return server, zoneID, client
+}
+
+func TestListWithFiltersAndPagination(t *testing.T) {
+	server, zoneID, client := newTestClient()
+	defer server.Close()
+
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		server.AddDNSRecord(zoneID, map[string]any{
+			"type":    "A",
+			"name":    name + ".example.com",
+			"content": "192.168.0.1",
+			"comment": "testing",
+		})
+	}
+	server.AddDNSRecord(zoneID, map[string]any{
+		"type":    "TXT",
+		"name":    "a.example.com",
+		"content": "hello",
+	})
+
+	// filters
+	filter := cfgo.NewDNSRecordFilter().
+		Type(cfgo.A).
+		Comment(cfgo.FilterContains, "test").
+		Order(cfgo.OrderByName, cfgo.OrderDescending)
+	if records, err := client.ListDNSRecords(zoneID, filter.Queries()); err == nil {
+		if len(records.Result) != 5 {
+			t.Errorf("expected 5 records, but got %d", len(records.Result))
+		} else if name, _ := records.Result[0].StringFor("name"); name != "e.example.com" {
+			t.Errorf("expected 'e.example.com' first, but got '%s'", name)
+		}
+	} else {
+		t.Errorf("failed to list dns records: %s", err)
+	}
+
+	// pagination
+	count := 0
+	for _, err := range client.IterateDNSRecords(context.Background(), zoneID, nil, 2) {
+		if err != nil {
+			t.Fatalf("failed to iterate dns records: %s", err)
+		}
+		count++
+	}
+	if count != 6 {
+		t.Errorf("expected 6 records, but iterated %d", count)
+	}
+	if server.Requests() != 1+3 {
+		t.Errorf("expected 4 requests, but was %d", server.Requests())
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	server, zoneID, client := newTestClient()
+	defer server.Close()
+
+	// invalid ip address
+	if _, err := client.CreateDNSRecord(zoneID, cfgo.NewDNSRecordA("invalid", "not-an-ip")); err == nil {
+		t.Errorf("should fail with an invalid ip address")
+	} else if apiErr, ok := err.(*cfgo.APIError); !ok || !apiErr.HasErrorCode(9005) {
+		t.Errorf("expected error code 9005, but got: %s", err)
+	}
+
+	// identical record
+	record := cfgo.NewDNSRecordTXT("duplicated", "hello")
+	if _, err := client.CreateDNSRecord(zoneID, record); err != nil {
+		t.Errorf("failed to create dns record: %s", err)
+	}
+	if _, err := client.CreateDNSRecord(zoneID, record); !cfgo.IsRecordAlreadyExists(err) {
+		t.Errorf("should fail with an identical record, but got: %v", err)
+	}
+
+	// record which does not exist
+	if _, err := client.DeleteDNSRecord(zoneID, "no-such-record"); !cfgo.IsNotFound(err) {
+		t.Errorf("should fail with a record not found, but got: %v", err)
+	}
+
+	// missing credentials
+	response, err := http.Get(server.BaseURL() + "/zones")
+	if err != nil {
+		t.Fatalf("failed to request: %s", err)
+	}
+	_ = response.Body.Close()
+	if response.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected status code 400, but got %d", response.StatusCode)
+	}
+}
+
+func TestRateLimitNext(t *testing.T) {
+	server, _, client := newTestClient()
+	defer server.Close()
+
+	// without retries
+	server.RateLimitNext(1)
+	if _, err := client.ListZones(); !cfgo.IsRateLimited(err) {
+		t.Errorf("should be rate limited, but got: %v", err)
+	}
+
+	// with retries
+	client.RetryPolicy = &cfgo.RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+	}
+	server.RateLimitNext(2)
+	if _, err := client.ListZones(); err != nil {
+		t.Errorf("failed to list zones with retries: %s", err)
+	}
+	if server.Requests() != 1+3 {
+		t.Errorf("expected 4 requests, but was %d", server.Requests())
+	}
+}
+
+func TestExportDNSRecords(t *testing.T) {
+	server, zoneID, client := newTestClient()
+	defer server.Close()
+
+	server.AddDNSRecord(zoneID, map[string]any{"type": "A", "name": "www.example.com", "content": "1.2.3.4", "ttl": 300})
+	server.AddDNSRecord(zoneID, map[string]any{"type": "MX", "name": "example.com", "content": "mx.example.com", "priority": 10})
+	server.AddDNSRecord(zoneID, map[string]any{"type": "TXT", "content": "record without a name"})
+
+	reader, err := client.ExportDNSRecords(zoneID)
+	if err != nil {
+		t.Fatalf("failed to export dns records: %s", err)
+	}
+	defer func() { _ = reader.Close() }()
+	bytes, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("failed to read exported dns records: %s", err)
+	}
+	exported := string(bytes)
+	for _, expected := range []string{
+		"www.example.com.\t300\tIN\tA\t1.2.3.4",
+		"example.com.\t1\tIN\tMX\t10 mx.example.com.",
+		";; (not exported)",
+	} {
+		if !strings.Contains(exported, expected) {
+			t.Errorf("'%s' was not found in exported zone file: %s", expected, exported)
+		}
+	}
+	if strings.Contains(exported, "record without a name") {
+		t.Errorf("record without a name should not be exported: %s", exported)
+	}
+}
+
+func TestImportDNSRecords(t *testing.T) {
+	server, zoneID, client := newTestClient()
+	defer server.Close()
+
+	imported, err := client.ImportDNSRecords(zoneID, strings.NewReader(`$ORIGIN example.com.
+www	300	IN	A	1.2.3.4
+mail	IN	MX	10 mx.example.com.
+_sip._tcp	IN	SRV	10 5 5060 sip.example.com.
+`), &cfgo.DNSRecordsImportOptions{Proxied: true})
+	if err != nil {
+		t.Fatalf("failed to import dns records: %s", err)
+	}
+	if imported.Result.RecsAdded != 2 || imported.Result.TotalRecordsParsed != 3 {
+		t.Errorf("expected 2 of 3 records added, but got %+v", imported.Result)
+	}
+
+	records := server.DNSRecords(zoneID)
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, but got %d", len(records))
+	}
+	for _, record := range records {
+		switch record["type"] {
+		case "A":
+			if record["name"] != "www.example.com" || record["proxied"] != true {
+				t.Errorf("expected a proxied A record, but got %+v", record)
+			}
+		case "MX":
+			if record["name"] != "mail.example.com" || record["proxied"] != nil {
+				t.Errorf("expected a not-proxiable MX record, but got %+v", record)
+			}
+		}
+	}
+
+	// malformed zone file
+	if _, err := client.ImportDNSRecords(zoneID, strings.NewReader("www IN A"), nil); err == nil {
+		t.Errorf("should fail with a malformed zone file")
+	}
+}
@@ -0,0 +1,288 @@
+package cfgotest
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// max size of uploaded zone files
+const maxZoneFileBytes = 10 << 20
+
+// GET /zones/{zone_id}/dns_records/export
+func (s *Server) exportDNSRecords(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rs, err := s.recordSet(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, ";;\n;; Domain:     %s.\n;; Exported:   %s\n;;\n", rs.zone["name"], now())
+	for _, record := range rs.records {
+		if line, ok := zoneFileLine(record); ok {
+			sb.WriteString(line + "\n")
+		} else {
+			fmt.Fprintf(&sb, ";; (not exported) %s %s\n", record["name"], record["type"])
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte(sb.String()))
+}
+
+// returns a line of zone file (RFC 1035) for given record
+func zoneFileLine(record map[string]any) (line string, ok bool) {
+	name, _ := record["name"].(string)
+	if name == "" {
+		return "", false
+	}
+	typ3, _ := record["type"].(string)
+	content, _ := record["content"].(string)
+	ttl, _ := record["ttl"].(float64)
+	data, _ := record["data"].(map[string]any)
+
+	var rdata string
+	switch typ3 {
+	case "A", "AAAA":
+		rdata = content
+	case "CNAME", "NS", "PTR":
+		rdata = fqdn(content)
+	case "MX":
+		priority, _ := record["priority"].(float64)
+		rdata = fmt.Sprintf("%d %s", int(priority), fqdn(content))
+	case "TXT":
+		rdata = content
+		if !strings.HasPrefix(rdata, `"`) {
+			rdata = fmt.Sprintf("%q", content)
+		}
+	case "SRV":
+		if data == nil {
+			rdata = content
+		} else {
+			rdata = fmt.Sprintf("%v %v %v %s", data["priority"], data["weight"], data["port"], fqdn(fmt.Sprintf("%v", data["target"])))
+		}
+	case "CAA":
+		if data == nil {
+			rdata = content
+		} else {
+			rdata = fmt.Sprintf("%v %v %q", data["flags"], data["tag"], fmt.Sprintf("%v", data["value"]))
+		}
+	default:
+		rdata = content
+	}
+	if rdata == "" {
+		return "", false
+	}
+
+	return fmt.Sprintf("%s\t%d\tIN\t%s\t%s", fqdn(name), int(ttl), typ3, rdata), true
+}
+
+// returns given domain name with a trailing dot
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// POST /zones/{zone_id}/dns_records/import
+//
+// Only A, AAAA, CNAME, MX, NS, PTR, and TXT records are imported. (others are parsed, but not added)
+func (s *Server) importDNSRecords(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseMultipartForm(maxZoneFileBytes); err != nil {
+		writeError(w, &apiError{http.StatusBadRequest, errCodeBadRequest, fmt.Sprintf("Malformed multipart body: %s", err)})
+		return
+	}
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, &apiError{http.StatusBadRequest, errCodeBadRequest, "Zone file is missing."})
+		return
+	}
+	defer file.Close()
+	proxied := r.FormValue("proxied") == "true"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rs, apiErr := s.recordSet(r)
+	if apiErr != nil {
+		writeError(w, apiErr)
+		return
+	}
+
+	records, err := parseZoneFile(file, rs.zone["name"].(string))
+	if err != nil {
+		writeError(w, &apiError{http.StatusBadRequest, errCodeBadRequest, fmt.Sprintf("Failed to parse zone file: %s", err)})
+		return
+	}
+
+	added := 0
+	for _, record := range records {
+		if typ3, _ := record["type"].(string); proxied && slices.Contains(proxiableTypes, typ3) {
+			record["proxied"] = true
+		}
+		if _, err := rs.create(record); err == nil {
+			added++
+		}
+	}
+	s.records[r.PathValue("zone_id")] = rs.records
+
+	writeResult(w, map[string]any{
+		"recs_added":           added,
+		"total_records_parsed": len(records),
+	}, nil)
+}
+
+// parses records from given zone file (RFC 1035), except SOA records
+func parseZoneFile(reader io.Reader, origin string) (records []map[string]any, err error) {
+	ttl := float64(1)
+	name := origin
+
+	scanner := bufio.NewScanner(reader)
+	inParentheses := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		tokens := zoneFileTokens(line)
+
+		// skip multi-line values (eg. SOA records)
+		if inParentheses {
+			inParentheses = !slices.Contains(tokens, ")")
+			continue
+		}
+		if slices.Contains(tokens, "(") && !slices.Contains(tokens, ")") {
+			inParentheses = true
+			continue
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+
+		// directives
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) > 1 {
+				origin = strings.TrimSuffix(strings.ToLower(tokens[1]), ".")
+			}
+			continue
+		case "$TTL":
+			if len(tokens) > 1 {
+				if f, err := strconv.ParseFloat(tokens[1], 64); err == nil {
+					ttl = f
+				}
+			}
+			continue
+		}
+
+		// owner name (same as the previous one when omitted)
+		if !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+			name = absoluteName(tokens[0], origin)
+			tokens = tokens[1:]
+		}
+
+		// ttl and class (in any order)
+		recordTTL := ttl
+		for len(tokens) > 0 {
+			if f, err := strconv.ParseFloat(tokens[0], 64); err == nil {
+				recordTTL = f
+			} else if !strings.EqualFold(tokens[0], "IN") {
+				break
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("invalid line: '%s'", line)
+		}
+
+		typ3 := strings.ToUpper(tokens[0])
+		rdata := tokens[1:]
+		if typ3 == "SOA" {
+			continue
+		}
+
+		record := map[string]any{
+			"type": typ3,
+			"name": name,
+			"ttl":  recordTTL,
+		}
+		switch typ3 {
+		case "A", "AAAA":
+			record["content"] = rdata[0]
+		case "CNAME", "NS", "PTR":
+			record["content"] = absoluteName(rdata[0], origin)
+		case "MX":
+			if len(rdata) < 2 {
+				return nil, fmt.Errorf("invalid MX record: '%s'", line)
+			}
+			priority, err := strconv.ParseFloat(rdata[0], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid MX priority: '%s'", line)
+			}
+			record["priority"] = priority
+			record["content"] = absoluteName(rdata[1], origin)
+		case "TXT":
+			strs := []string{}
+			for _, str := range rdata {
+				strs = append(strs, strings.Trim(str, `"`))
+			}
+			record["content"] = strings.Join(strs, "")
+		}
+		records = append(records, record)
+	}
+
+	return records, scanner.Err()
+}
+
+// splits given line of zone file into tokens, without comments (quoted strings are kept as single tokens)
+func zoneFileTokens(line string) (tokens []string) {
+	var token strings.Builder
+	quoted := false
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	for _, c := range line {
+		switch {
+		case c == '"':
+			quoted = !quoted
+			token.WriteRune(c)
+		case quoted:
+			token.WriteRune(c)
+		case c == ';':
+			flush()
+			return tokens
+		case c == ' ' || c == '\t':
+			flush()
+		case c == '(' || c == ')':
+			flush()
+			tokens = append(tokens, string(c))
+		default:
+			token.WriteRune(c)
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+// returns given (possibly relative) name as an absolute one without a trailing dot
+func absoluteName(name, origin string) string {
+	name = strings.ToLower(name)
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return strings.TrimSuffix(name, ".")
+	}
+	return name + "." + origin
+}
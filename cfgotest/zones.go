@@ -0,0 +1,306 @@
+package cfgotest
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+const (
+	fakeAccountID   = "0123456789abcdef0123456789abcdef"
+	fakeAccountName = "Fake Account"
+
+	freePlanID = "0feeeeeeeeeeeeeeeeeeeeeeeeeeeeee"
+)
+
+// types of zones
+var zoneTypes = []string{"full", "partial", "secondary"}
+
+// AddZone adds a new active zone with given name, and returns its identifier.
+func (s *Server) AddZone(name string) (zoneID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zone := s.newZone(name, fakeAccountID, fakeAccountName, "full")
+	zone["status"] = "active"
+	zone["activated_on"] = zone["created_on"]
+
+	return zone["id"].(string)
+}
+
+// creates and stores a new pending zone (should be called with the lock held)
+func (s *Server) newZone(name, accountID, accountName, typ3 string) map[string]any {
+	timestamp := now()
+
+	zone := map[string]any{
+		"id":     newID(),
+		"name":   strings.ToLower(name),
+		"status": "pending",
+		"paused": false,
+		"type":   typ3,
+		"account": map[string]any{
+			"id":   accountID,
+			"name": accountName,
+		},
+		"name_servers": []any{
+			"ns1.fake.cloudflare.test",
+			"ns2.fake.cloudflare.test",
+		},
+		"created_on":   timestamp,
+		"modified_on":  timestamp,
+		"activated_on": nil,
+		"plan": map[string]any{
+			"id":   freePlanID,
+			"name": "Free Website",
+		},
+	}
+	s.zones = append(s.zones, zone)
+	s.records[zone["id"].(string)] = []map[string]any{}
+
+	return zone
+}
+
+// returns the zone with given identifier (should be called with the lock held)
+func (s *Server) zone(zoneID string) map[string]any {
+	for _, zone := range s.zones {
+		if zone["id"] == zoneID {
+			return zone
+		}
+	}
+
+	return nil
+}
+
+// returns an error for a zone which does not exist
+func zoneNotFound(r *http.Request) *apiError {
+	return &apiError{http.StatusNotFound, errCodeInvalidRoute, "Could not route to " + r.URL.Path + ", perhaps your object identifier is invalid?"}
+}
+
+// GET /zones
+func (s *Server) listZones(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query := r.URL.Query()
+	matchAny := query.Get("match") == "any"
+
+	filtered := []map[string]any{}
+	for _, zone := range s.zones {
+		account, _ := zone["account"].(map[string]any)
+
+		conditions := []bool{}
+		if name := query.Get("name"); name != "" {
+			conditions = append(conditions, matchString(zone["name"], name))
+		}
+		if status := query.Get("status"); status != "" {
+			conditions = append(conditions, zone["status"] == status)
+		}
+		if accountID := query.Get("account.id"); accountID != "" {
+			conditions = append(conditions, account["id"] == accountID)
+		}
+		if accountName := query.Get("account.name"); accountName != "" {
+			conditions = append(conditions, matchString(account["name"], accountName))
+		}
+
+		if matches(conditions, matchAny) {
+			filtered = append(filtered, zone)
+		}
+	}
+
+	page, perPage := pageParams(r, defaultZonesPerPage, maxZonesPerPage)
+	paged, info := paginate(filtered, page, perPage)
+
+	writeResult(w, deepCopy(paged), info)
+}
+
+// GET /zones/{zone_id}
+func (s *Server) getZone(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zone := s.zone(r.PathValue("zone_id"))
+	if zone == nil {
+		writeError(w, zoneNotFound(r))
+		return
+	}
+
+	writeResult(w, deepCopy(zone), nil)
+}
+
+// POST /zones
+func (s *Server) createZone(w http.ResponseWriter, r *http.Request) {
+	var input struct {
+		Name    string `json:"name"`
+		Account struct {
+			ID string `json:"id"`
+		} `json:"account"`
+		Type string `json:"type"`
+	}
+	if err := decodeBody(r, &input); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	name := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(input.Name)), ".")
+	if !strings.Contains(name, ".") {
+		writeError(w, &apiError{http.StatusBadRequest, errCodeInvalidZoneName, "Invalid domain name: '" + input.Name + "'"})
+		return
+	}
+	if input.Account.ID == "" {
+		writeError(w, &apiError{http.StatusBadRequest, errCodeBadRequest, "Account identifier is missing."})
+		return
+	}
+	if input.Type == "" {
+		input.Type = "full"
+	} else if !slices.Contains(zoneTypes, input.Type) {
+		writeError(w, &apiError{http.StatusBadRequest, errCodeBadRequest, "Invalid zone type: '" + input.Type + "'"})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, zone := range s.zones {
+		if zone["name"] == name {
+			writeError(w, &apiError{http.StatusBadRequest, errCodeZoneAlreadyExists, name + " already exists"})
+			return
+		}
+	}
+
+	accountName := ""
+	if input.Account.ID == fakeAccountID {
+		accountName = fakeAccountName
+	}
+	zone := s.newZone(name, input.Account.ID, accountName, input.Type)
+
+	writeResult(w, deepCopy(zone), nil)
+}
+
+// PATCH /zones/{zone_id}
+func (s *Server) editZone(w http.ResponseWriter, r *http.Request) {
+	var input map[string]any
+	if err := decodeBody(r, &input); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zone := s.zone(r.PathValue("zone_id"))
+	if zone == nil {
+		writeError(w, zoneNotFound(r))
+		return
+	}
+
+	if paused, exists := input["paused"]; exists {
+		if _, ok := paused.(bool); !ok {
+			writeError(w, &apiError{http.StatusBadRequest, errCodeBadRequest, "Invalid value for 'paused'."})
+			return
+		}
+		zone["paused"] = paused
+	}
+	if typ3, exists := input["type"]; exists {
+		if t, _ := typ3.(string); !slices.Contains(zoneTypes, t) {
+			writeError(w, &apiError{http.StatusBadRequest, errCodeBadRequest, "Invalid zone type."})
+			return
+		}
+		zone["type"] = typ3
+	}
+	if plan, exists := input["plan"].(map[string]any); exists {
+		zone["plan"] = map[string]any{"id": plan["id"]}
+	}
+	if nameServers, exists := input["vanity_name_servers"]; exists {
+		zone["vanity_name_servers"] = nameServers
+	}
+	zone["modified_on"] = now()
+
+	writeResult(w, deepCopy(zone), nil)
+}
+
+// DELETE /zones/{zone_id}
+func (s *Server) deleteZone(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zoneID := r.PathValue("zone_id")
+	index := slices.IndexFunc(s.zones, func(zone map[string]any) bool {
+		return zone["id"] == zoneID
+	})
+	if index < 0 {
+		writeError(w, zoneNotFound(r))
+		return
+	}
+
+	s.zones = slices.Delete(s.zones, index, index+1)
+	delete(s.records, zoneID)
+
+	writeResult(w, map[string]any{"id": zoneID}, nil)
+}
+
+// PUT /zones/{zone_id}/activation_check
+//
+// Pending zones are activated immediately.
+func (s *Server) triggerActivationCheck(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zone := s.zone(r.PathValue("zone_id"))
+	if zone == nil {
+		writeError(w, zoneNotFound(r))
+		return
+	}
+
+	if zone["status"] == "pending" {
+		zone["status"] = "active"
+		zone["activated_on"] = now()
+	}
+
+	writeResult(w, map[string]any{"id": zone["id"]}, nil)
+}
+
+// matches a zone's string value with given filter value,
+// which can be prefixed with an operator (eg. `contains:example`)
+func matchString(value any, filter string) bool {
+	str, _ := value.(string)
+	str = strings.ToLower(str)
+
+	op, operand, found := strings.Cut(filter, ":")
+	if !found {
+		return str == strings.ToLower(filter)
+	}
+	operand = strings.ToLower(operand)
+
+	switch op {
+	case "equal":
+		return str == operand
+	case "not_equal":
+		return str != operand
+	case "contains":
+		return strings.Contains(str, operand)
+	case "starts_with":
+		return strings.HasPrefix(str, operand)
+	case "ends_with":
+		return strings.HasSuffix(str, operand)
+	}
+
+	return str == strings.ToLower(filter)
+}
+
+// checks if given conditions are met (all of them, or any of them)
+func matches(conditions []bool, matchAny bool) bool {
+	if len(conditions) == 0 {
+		return true
+	}
+
+	for _, met := range conditions {
+		if matchAny && met {
+			return true
+		}
+		if !matchAny && !met {
+			return false
+		}
+	}
+
+	return !matchAny
+}
@@ -1,6 +1,7 @@
 package cfgo
 
 import (
+	"log/slog"
 	"net"
 	"net/http"
 	"time"
@@ -8,35 +9,103 @@ import (
 
 const (
 	timeoutSeconds = 10
+
+	keepAliveSeconds       = 30
+	idleConnTimeoutSeconds = 90
+	maxIdleConns           = 100
+	maxIdleConnsPerHost    = 32 // all requests go to the same API host
 )
 
 // CloudflareClient struct
 type CloudflareClient struct {
-	Email  string
+	// account email for authentication, used instead of the authenticator when set (with `APIKey`)
+	//
+	// Deprecated: use `NewCloudflareClient`, or `NewCloudflareClientWithAuthenticator` with an `APIKeyAuthenticator` instead.
+	Email string
+
+	// global api key for authentication, used instead of the authenticator when set (with `Email`)
+	//
+	// Deprecated: use `NewCloudflareClient`, or `NewCloudflareClientWithAuthenticator` with an `APIKeyAuthenticator` instead.
 	APIKey string
 
+	authenticator Authenticator
+
+	baseURL    string
 	httpClient *http.Client
+	headers    http.Header // default headers
+
+	zoneCache zoneCache // cached zones for resolving zones by domain names
+
+	// retry policy for failed requests (no retries when nil)
+	RetryPolicy *RetryPolicy
 
+	// rate limiter for pacing requests (no limits when nil)
+	//
+	// can be shared among multiple clients
+	RateLimiter *RateLimiter
+
+	// logger for structured request/response events (logged at debug level, with credentials redacted)
+	Logger *slog.Logger
+
+	// log request/response events to stderr (when `Logger` is not set)
 	Verbose bool
 }
 
-// NewCloudflareClient returns a new cloudflare API client with given api key.
-func NewCloudflareClient(email, apiKey string) *CloudflareClient {
-	return &CloudflareClient{
+// NewCloudflareClient returns a new cloudflare API client with given email and (global) api key.
+func NewCloudflareClient(email, apiKey string, opts ...Option) *CloudflareClient {
+	c := NewCloudflareClientWithAuthenticator(APIKeyAuthenticator{
 		Email:  email,
 		APIKey: apiKey,
+	}, opts...)
+
+	// for backward compatibility
+	c.Email, c.APIKey = email, apiKey
+
+	return c
+}
 
+// NewCloudflareClientWithAPIToken returns a new cloudflare API client with given (scoped) api token.
+func NewCloudflareClientWithAPIToken(apiToken string, opts ...Option) *CloudflareClient {
+	return NewCloudflareClientWithAuthenticator(APITokenAuthenticator{
+		Token: apiToken,
+	}, opts...)
+}
+
+// NewCloudflareClientWithAuthenticator returns a new cloudflare API client with given authenticator.
+//
+// Client can be configured with options, eg. `WithBaseURL` or `WithHTTPClient`.
+func NewCloudflareClientWithAuthenticator(authenticator Authenticator, opts ...Option) *CloudflareClient {
+	c := &CloudflareClient{
+		authenticator: authenticator,
+
+		baseURL: defaultBaseURL,
+		headers: http.Header{},
 		httpClient: &http.Client{
-			Transport: &http.Transport{
-				DialContext: (&net.Dialer{
-					Timeout:   timeoutSeconds * time.Second,
-					KeepAlive: timeoutSeconds * time.Second,
-				}).DialContext,
-				IdleConnTimeout:       timeoutSeconds * time.Second,
-				TLSHandshakeTimeout:   timeoutSeconds * time.Second,
-				ResponseHeaderTimeout: timeoutSeconds * time.Second,
-				ExpectContinueTimeout: timeoutSeconds * time.Second,
-			},
+			Transport: newTransport(),
 		},
 	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// returns a new transport tuned for reusing (keep-alive, HTTP/2) connections
+func newTransport() *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   timeoutSeconds * time.Second,
+			KeepAlive: keepAliveSeconds * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          maxIdleConns,
+		MaxIdleConnsPerHost:   maxIdleConnsPerHost,
+		IdleConnTimeout:       idleConnTimeoutSeconds * time.Second,
+		TLSHandshakeTimeout:   timeoutSeconds * time.Second,
+		ResponseHeaderTimeout: timeoutSeconds * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
 }
@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -16,7 +18,6 @@ import (
 
 	// infisical
 	infisical "github.com/infisical/go-sdk"
-	"github.com/infisical/go-sdk/packages/models"
 
 	// my libraries
 	cfgo "github.com/meinside/cloudflare-go"
@@ -36,8 +37,15 @@ const (
 	cmdUpdate   = "update"
 	cmdBatch    = "batch"
 	cmdDelete   = "delete"
+	cmdExport   = "export"
+	cmdImport   = "import"
 	cmdGenerate = "generate"
 
+	// sub-commands of zones
+	cmdPause   = "pause"
+	cmdUnpause = "unpause"
+	cmdCheck   = "check"
+
 	regexKeyValue = `(.*?)=['"]?(.*?)['"]?$`
 	regexFloat    = `^[-+]?\d*[.]\d+$`
 	regexInt      = `^[+-]?\d+$`
@@ -46,8 +54,9 @@ const (
 // config struct for configuration
 type config struct {
 	// plain values
-	Email  *string `json:"email,omitempty"`
-	APIKey *string `json:"api_key,omitempty"`
+	Email    *string `json:"email,omitempty"`
+	APIKey   *string `json:"api_key,omitempty"`
+	APIToken *string `json:"api_token,omitempty"`
 
 	// or Infisical settings
 	Infisical *struct {
@@ -58,14 +67,15 @@ type config struct {
 		Environment string `json:"environment"`
 		SecretType  string `json:"secret_type"`
 
-		EmailKeyPath  string `json:"email_key_path"`
-		APIKeyKeyPath string `json:"api_key_key_path"`
+		EmailKeyPath    string `json:"email_key_path,omitempty"`
+		APIKeyKeyPath   string `json:"api_key_key_path,omitempty"`
+		APITokenKeyPath string `json:"api_token_key_path,omitempty"`
 	} `json:"infisical,omitempty"`
 }
 
-// get email and api key, retrieve them from infisical if needed
-func (c *config) GetEmailAndAPIKey() (email, apiKey *string, err error) {
-	if c.Email == nil && c.APIKey == nil && c.Infisical != nil {
+// get email and api key (or api token), retrieve them from infisical if needed
+func (c *config) GetCredentials() (email, apiKey, apiToken *string, err error) {
+	if c.Email == nil && c.APIKey == nil && c.APIToken == nil && c.Infisical != nil {
 		client := infisical.NewInfisicalClient(context.TODO(), infisical.Config{
 			SiteUrl: "https://app.infisical.com",
 		})
@@ -73,49 +83,47 @@ func (c *config) GetEmailAndAPIKey() (email, apiKey *string, err error) {
 		_, err = client.Auth().UniversalAuthLogin(c.Infisical.ClientID, c.Infisical.ClientSecret)
 		if err != nil {
 			_stderr.Printf("* failed to authenticate with Infisical: %s", err)
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
-		// read email and api key from infisical
-		var keyPath string
-		var secret models.Secret
-
-		// email
-		keyPath = c.Infisical.EmailKeyPath
-		secret, err = client.Secrets().Retrieve(infisical.RetrieveSecretOptions{
-			SecretKey:   path.Base(keyPath),
-			SecretPath:  path.Dir(keyPath),
-			ProjectID:   c.Infisical.ProjectID,
-			Type:        c.Infisical.SecretType,
-			Environment: c.Infisical.Environment,
-		})
-		if err == nil {
+		// read a secret value from infisical
+		retrieve := func(keyPath string) (*string, error) {
+			secret, err := client.Secrets().Retrieve(infisical.RetrieveSecretOptions{
+				SecretKey:   path.Base(keyPath),
+				SecretPath:  path.Dir(keyPath),
+				ProjectID:   c.Infisical.ProjectID,
+				Type:        c.Infisical.SecretType,
+				Environment: c.Infisical.Environment,
+			})
+			if err != nil {
+				return nil, err
+			}
 			value := secret.SecretValue
-			c.Email = &value
-		} else {
-			_stderr.Printf("* failed to retrieve email from infisical: %s\n", err)
-			return nil, nil, err
+			return &value, nil
 		}
 
-		// api key
-		keyPath = c.Infisical.APIKeyKeyPath
-		secret, err = client.Secrets().Retrieve(infisical.RetrieveSecretOptions{
-			SecretKey:   path.Base(keyPath),
-			SecretPath:  path.Dir(keyPath),
-			ProjectID:   c.Infisical.ProjectID,
-			Type:        c.Infisical.SecretType,
-			Environment: c.Infisical.Environment,
-		})
-		if err == nil {
-			value := secret.SecretValue
-			c.APIKey = &value
+		if c.Infisical.APITokenKeyPath != "" {
+			// api token
+			if c.APIToken, err = retrieve(c.Infisical.APITokenKeyPath); err != nil {
+				_stderr.Printf("* failed to retrieve api token from infisical: %s\n", err)
+				return nil, nil, nil, err
+			}
 		} else {
-			_stderr.Printf("* failed to retrieve api key from infisical: %s\n", err)
-			return nil, nil, err
+			// email
+			if c.Email, err = retrieve(c.Infisical.EmailKeyPath); err != nil {
+				_stderr.Printf("* failed to retrieve email from infisical: %s\n", err)
+				return nil, nil, nil, err
+			}
+
+			// api key
+			if c.APIKey, err = retrieve(c.Infisical.APIKeyKeyPath); err != nil {
+				_stderr.Printf("* failed to retrieve api key from infisical: %s\n", err)
+				return nil, nil, nil, err
+			}
 		}
 	}
 
-	return c.Email, c.APIKey, nil
+	return c.Email, c.APIKey, c.APIToken, nil
 }
 
 // standardize given JSON (JWCC) bytes
@@ -176,6 +184,31 @@ func flagExists(args []string, short, long string) bool {
 	return false
 }
 
+// returns the value of a long flag argument like `--flag=value` in the args
+func flagValue(args []string, long string) (value string, exists bool) {
+	for _, arg := range args {
+		if v, found := strings.CutPrefix(arg, long+"="); found {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+// short flags which are followed by their values (eg. `-o FILEPATH`)
+var shortFlagsWithValues = []string{"-o"}
+
+// returns the value of a short/long flag argument like `-f value` or `--flag=value` in the args
+func flagValueWithShort(args []string, short, long string) (value string, exists bool) {
+	for i, arg := range args {
+		if arg == short && i+1 < len(args) {
+			return args[i+1], true
+		}
+	}
+
+	return flagValue(args, long)
+}
+
 // encode json string for debugging
 func jsonString(v any) string {
 	if bytes, err := json.Marshal(v); err == nil {
@@ -199,28 +232,73 @@ func showHelp(applicationName string, err error) {
 
   -v / --verbose: Show verbose messages for debugging purpose.
 
+  -a / --atomic: Apply all DNS records of a zone at once (all or nothing) with '%[7]s' command.
+
+  --record=CASSETTE_FILEPATH: Record API interactions (with credentials stripped) to the given file.
+
+  --replay=CASSETTE_FILEPATH: Replay API interactions from the given file, without sending requests.
+
+  --type=ZONE_TYPE: Type of a new zone (full, partial, or secondary) with '%[3]s %[5]s' command.
+
+  -j / --jump-start: Scan for existing DNS records of a new zone with '%[3]s %[5]s' command.
+
+  -o FILEPATH / --output=FILEPATH: Write exported DNS records to the given file with '%[13]s' command.
+
+  -p / --proxied: Proxy imported A, AAAA, and CNAME records with '%[14]s' command.
+
 
 <Commands and parameters>
 
+  [ZONE] can be a zone identifier, or a domain name in the zone. (e.g. 'example.com' or 'sub.example.com')
+
+  For '%[3]s' commands, [ZONE] should be a zone identifier, or the exact name of the zone. (e.g. 'example.com')
+
 List all zones for this account.
 
   $ %[1]s %[3]s
 
-List all DNS records for given zone identifier.
+Create a zone with given account identifier and domain name.
+
+  $ %[1]s %[3]s %[5]s [ACCOUNT_ID] [DOMAIN_NAME]
+
+  e.g.: $ %[1]s %[3]s %[5]s abcd123456 example.com --type=full --jump-start
 
-  $ %[1]s %[4]s [ZONE_ID]
+Delete a zone.
+
+  $ %[1]s %[3]s %[8]s [ZONE]
+
+Pause (or unpause) Cloudflare services on a zone.
+
+  $ %[1]s %[3]s %[10]s [ZONE]
+  $ %[1]s %[3]s %[11]s [ZONE]
+
+Trigger an activation check for a pending zone.
+
+  $ %[1]s %[3]s %[12]s [ZONE]
+
+List all DNS records for given zone.
+
+  $ %[1]s %[4]s [ZONE]
 
 Create a DNS record with given parameters.
 
-  $ %[1]s %[5]s [ZONE_ID] [RECORD_TYPE] [key1=value1 key2=value2 ...]
+  $ %[1]s %[5]s [ZONE] [RECORD_TYPE] [key1=value1 key2=value2 ...]
 
-  e.g.: $ %[1]s %[5]s abcd123456 CNAME name=sub.from.com content=dest.com comment="New record."
+  e.g.: $ %[1]s %[5]s from.com CNAME name=sub.from.com content=dest.com comment="New record."
 
 Update a DNS record with given parameters.
 
-  $ %[1]s %[6]s [ZONE_ID] [RECORD_ID] [key1=value1 key2=value2 ...]
+  $ %[1]s %[6]s [ZONE] [RECORD_ID] [key1=value1 key2=value2 ...]
+
+  e.g.: $ %[1]s %[6]s from.com wxyz098765 type=CNAME name=sub.from.com content=updated-dest.com comment="Updated record."
+
+Update (or create if there is none) a DNS record with given type and name.
 
-  e.g.: $ %[1]s %[6]s abcd123456 wxyz098765 type=CNAME name=sub.from.com content=updated-dest.com comment="Updated record."
+  $ %[1]s %[6]s [ZONE] [RECORD_TYPE] [RECORD_NAME] [key1=value1 key2=value2 ...]
+
+  e.g.: $ %[1]s %[6]s example.com A ddns.example.com content=1.2.3.4
+
+  When there are multiple records with the same type and name, the one with the same content will be updated.
 
 Batch upsert all DNS records in the given JSON file.
 
@@ -228,15 +306,40 @@ Batch upsert all DNS records in the given JSON file.
 
   If a record has 'id' in it, it will be updated. Otherwise, it will be newly created instead.
 
+  'zone_id' of records can also be a domain name in the zone.
+
+  With '-a' or '--atomic' flag, records of each zone will be applied at once, and none of them will be applied on any failure.
+
+  Records are validated before being sent ('%[5]s' and '%[6]s' commands too), and invalid ones will not be applied.
+
 Delete a DNS record with given zone & record identifier.
 
-  $ %[1]s %[8]s [ZONE_ID] [RECORD_ID]
+  $ %[1]s %[8]s [ZONE] [RECORD_ID]
+
+Delete a DNS record with given type and name. (content is needed when there are multiple records with the same type and name)
+
+  $ %[1]s %[8]s [ZONE] [RECORD_TYPE] [RECORD_NAME] [content=VALUE]
+
+  e.g.: $ %[1]s %[8]s example.com A rr.example.com content=1.2.3.4
+
+Export all DNS records of a zone in BIND config format, to stdout or a file.
+
+  $ %[1]s %[13]s [ZONE] [-o FILEPATH]
+
+  e.g.: $ %[1]s %[13]s example.com -o example.com.zone
+
+Import DNS records of a zone from a file in BIND config format.
+
+  $ %[1]s %[14]s [ZONE] [ZONE_FILEPATH] [-p]
+
+  e.g.: $ %[1]s %[14]s example.com example.com.zone --proxied
 
 Generate a sample DNS records file in JSON format. (file used with '%[7]s' command)
 
   $ %[1]s %[9]s
 `, applicationName, version.Minimum(),
-		cmdZones, cmdRecords, cmdCreate, cmdUpdate, cmdBatch, cmdDelete, cmdGenerate)
+		cmdZones, cmdRecords, cmdCreate, cmdUpdate, cmdBatch, cmdDelete, cmdGenerate,
+		cmdPause, cmdUnpause, cmdCheck, cmdExport, cmdImport)
 
 	if err == nil {
 		os.Exit(0)
@@ -251,76 +354,97 @@ func showSampleRecords() {
 	const sampleRecordID = "--id-of-an-already-existing-record-here--"
 
 	records := []any{
-		cfgo.NewDNSRecordA("sample1.com", "1.2.3.4").
-			SetComment("A record for sampling").
-			SetZoneID(sampleZoneID).
-			SetID(sampleRecordID),
-		cfgo.NewDNSRecordAAAA("sample2.com", "beef:beef:beef:beef:beef:beef:beef:beef").
-			SetComment("AAAA record for sampling").
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordCAA("sample3.com", 0, "issue", "letsencrypt.org").
-			SetComment("CAA record for sampling").
-			SetID(sampleRecordID).
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordCERT("sample4.com", 8, "--certificate--", 1, 9).
-			SetComment("CERT record for sampling").
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordCNAME("sample.sample5.com", "somewhere.com").
-			SetComment("CNAME record for sampling").
-			SetID(sampleRecordID).
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordDNSKEY("sample6.com", 5, 1, 3, "--public-key--").
-			SetComment("DNSKEY record for sampling").
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordDS("sample7.com", 3, "--digest--", 1, 1).
-			SetComment("DS record for sampling").
-			SetID(sampleRecordID).
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordHTTPS("sample8.com", 1, ".", `alpn="h3,h2" ipv4hint="127.0.0.1" ipv6hint="::1"`).
-			SetComment("HTTPS record for sampling").
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordLOC("sample9.com", 0, 37, cfgo.North, 46, 46, 122, cfgo.West, 23, 35, 0, 0, 100).
-			SetComment("LOC record for sampling").
-			SetID(sampleRecordID).
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordMX("sample10.com", "mx.sample10.com", 10).
-			SetComment("MX record for sampling").
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordNAPTR("sample11.com", "flags", 100, 10, "regex", "replacement", "service").
-			SetComment("NAPTR record for sampling").
-			SetID(sampleRecordID).
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordNS("sample12.com", "ns1.sample12").
-			SetComment("NS record for sampling").
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordPTR("sample13.com", "ptr.sample13.com").
-			SetComment("PTR record for sampling").
-			SetID(sampleRecordID).
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordSMIMEA("sample14.com", "--cretificate--", 0, 0, 3).
-			SetComment("SMIMEA record for sampling").
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordSRV("sample15.com", 8806, 10, "_tcp", "_sip", "sample15.com", 5).
-			SetComment("SRV record for sampling").
-			SetID(sampleRecordID).
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordSSHFP("sample16.com", 2, "--fingerprint--", 1).
-			SetComment("SSHFP record for sampling").
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordSVCB("sample17.com", 1, ".", `alpn="h3,h2" ipv4hint="127.0.0.1" ipv6hint="::1"`).
-			SetComment("SVCB record for sampling").
-			SetID(sampleRecordID).
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordTLSA("sample18.com", "--certificate--", 1, 0, 0).
-			SetComment("TLSA record for sampling").
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordTXT("sample19.com", "sample text content").
-			SetComment("TXT record for sampling").
-			SetID(sampleRecordID).
-			SetZoneID(sampleZoneID),
-		cfgo.NewDNSRecordURI("sample20.com", "http://sample20.com/sample.html", 20).
-			SetComment("URI record for sampling").
-			SetZoneID(sampleZoneID),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordA("sample1.com", "1.2.3.4")).
+			Comment("A record for sampling").
+			ZoneID(sampleZoneID).
+			ID(sampleRecordID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordAAAA("sample2.com", "beef:beef:beef:beef:beef:beef:beef:beef")).
+			Comment("AAAA record for sampling").
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordCAA("sample3.com", 0, "issue", "letsencrypt.org")).
+			Comment("CAA record for sampling").
+			ID(sampleRecordID).
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordCERT("sample4.com", 8, "--certificate--", 1, 9)).
+			Comment("CERT record for sampling").
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordCNAME("sample.sample5.com", "somewhere.com")).
+			Proxied(true).
+			Comment("CNAME record for sampling").
+			ID(sampleRecordID).
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordDNSKEY("sample6.com", 5, 1, 3, "--public-key--")).
+			Comment("DNSKEY record for sampling").
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordDS("sample7.com", 3, "--digest--", 1, 1)).
+			Comment("DS record for sampling").
+			ID(sampleRecordID).
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordHTTPS("sample8.com", 1, ".", `alpn="h3,h2" ipv4hint="127.0.0.1" ipv6hint="::1"`)).
+			Comment("HTTPS record for sampling").
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordLOC("sample9.com", 0, 37, cfgo.North, 46, 46, 122, cfgo.West, 23, 35, 0, 0, 100)).
+			Comment("LOC record for sampling").
+			ID(sampleRecordID).
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordMX("sample10.com", "mx.sample10.com", 10)).
+			Comment("MX record for sampling").
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordNAPTR("sample11.com", "flags", 100, 10, "regex", "replacement", "service")).
+			Comment("NAPTR record for sampling").
+			ID(sampleRecordID).
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordNS("sample12.com", "ns1.sample12")).
+			Comment("NS record for sampling").
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordPTR("sample13.com", "ptr.sample13.com")).
+			Comment("PTR record for sampling").
+			ID(sampleRecordID).
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordSMIMEA("sample14.com", "--cretificate--", 0, 0, 3)).
+			Comment("SMIMEA record for sampling").
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordSRV("sample15.com", 8806, 10, "_tcp", "_sip", "sample15.com", 5)).
+			Comment("SRV record for sampling").
+			ID(sampleRecordID).
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordSSHFP("sample16.com", 2, "--fingerprint--", 1)).
+			Comment("SSHFP record for sampling").
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordSVCB("sample17.com", 1, ".", `alpn="h3,h2" ipv4hint="127.0.0.1" ipv6hint="::1"`)).
+			Comment("SVCB record for sampling").
+			ID(sampleRecordID).
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordTLSA("sample18.com", "--certificate--", 1, 0, 0)).
+			Comment("TLSA record for sampling").
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordTXT("sample19.com", "sample text content")).
+			Comment("TXT record for sampling").
+			ID(sampleRecordID).
+			ZoneID(sampleZoneID).
+			Record(),
+		cfgo.NewRecordBuilder(cfgo.NewDNSRecordURI("sample20.com", "http://sample20.com/sample.html", 20)).
+			Comment("URI record for sampling").
+			ZoneID(sampleZoneID).
+			Record(),
 	}
 
 	if bytes, err := json.MarshalIndent(records, "", "  "); err == nil {
@@ -336,8 +460,8 @@ func showSampleRecords() {
 
 // list all zones
 func listZones(client *cfgo.CloudflareClient) {
-	if zones, err := client.ListZones(); err == nil {
-		for _, zone := range zones.Result {
+	if zones, err := client.ListAllZones(); err == nil {
+		for _, zone := range zones {
 			_stdout.Printf("%s %s\n", zone.ID, zone.Name)
 		}
 
@@ -349,10 +473,99 @@ func listZones(client *cfgo.CloudflareClient) {
 	}
 }
 
+// create a zone with given account identifier and domain name
+func createZone(client *cfgo.CloudflareClient, accountID, name string, typ3 cfgo.ZoneType, jumpStart bool) {
+	params := cfgo.NewZoneCreation(accountID, name)
+	if typ3 != "" {
+		params.Type = typ3
+	}
+	params.JumpStart = jumpStart
+
+	if created, err := client.CreateZone(params); err == nil {
+		_stdout.Printf("created zone %s %s (%s)\n", created.Result.ID, created.Result.Name, created.Result.Status)
+		if len(created.Result.NameServers) > 0 {
+			_stdout.Printf("name servers: %s\n", strings.Join(created.Result.NameServers, ", "))
+		}
+
+		os.Exit(0)
+	} else {
+		_stderr.Printf("failed to create zone %s: %s\n", name, err)
+
+		os.Exit(1)
+	}
+}
+
+// resolve the identifier of a zone from given zone identifier or domain name (eg. `example.com` or `sub.example.com`)
+func resolveZoneID(client *cfgo.CloudflareClient, zone string) string {
+	zoneID, err := client.ResolveZoneID(zone)
+	if err != nil {
+		_stderr.Printf("failed to resolve zone '%s': %s\n", zone, err)
+
+		os.Exit(1)
+	}
+
+	return zoneID
+}
+
+// resolve the identifier of a zone from given zone identifier or exact zone name (eg. `example.com`, but not `sub.example.com`)
+func resolveExactZoneID(client *cfgo.CloudflareClient, zone string) string {
+	if cfgo.IsZoneID(zone) {
+		return zone
+	}
+
+	resolved, err := client.ResolveZoneByName(zone)
+	if err != nil {
+		_stderr.Printf("failed to resolve zone '%s' (exact zone name is needed): %s\n", zone, err)
+
+		os.Exit(1)
+	}
+
+	return resolved.ID
+}
+
+// delete a zone with given zone identifier
+func deleteZone(client *cfgo.CloudflareClient, zoneID string) {
+	if deleted, err := client.DeleteZone(zoneID); err == nil {
+		_stdout.Printf("deleted zone %s\n", deleted.Result.ID)
+
+		os.Exit(0)
+	} else {
+		_stderr.Printf("failed to delete zone %s: %s\n", zoneID, err)
+
+		os.Exit(1)
+	}
+}
+
+// pause (or unpause) a zone with given zone identifier
+func pauseZone(client *cfgo.CloudflareClient, zoneID string, paused bool) {
+	if edited, err := client.PauseZone(zoneID, paused); err == nil {
+		_stdout.Printf("zone %s %s: paused = %t\n", edited.Result.ID, edited.Result.Name, edited.Result.Paused)
+
+		os.Exit(0)
+	} else {
+		_stderr.Printf("failed to edit zone %s: %s\n", zoneID, err)
+
+		os.Exit(1)
+	}
+}
+
+// trigger an activation check for a zone with given zone identifier
+func checkZoneActivation(client *cfgo.CloudflareClient, zoneID string) {
+	if triggered, err := client.TriggerActivationCheck(zoneID); err == nil {
+		_stdout.Printf("triggered activation check for zone %s\n", triggered.Result.ID)
+
+		os.Exit(0)
+	} else {
+		_stderr.Printf("failed to trigger activation check for zone %s: %s\n", zoneID, err)
+
+		os.Exit(1)
+	}
+}
+
 // list all DNS records for given zone identifier
 func listDNSRecords(client *cfgo.CloudflareClient, zoneID string) {
-	if records, err := client.ListDNSRecords(zoneID, nil); err == nil {
-		for _, record := range records.Result {
+	if records, err := client.ListAllDNSRecords(zoneID, nil); err == nil {
+		for _, record := range records {
 			if name, err := record.StringFor("name"); err == nil {
 				if id, err := record.StringFor("id"); err == nil {
 					if typ3, err := record.StringFor("type"); err == nil {
@@ -380,13 +593,19 @@ func listDNSRecords(client *cfgo.CloudflareClient, zoneID string) {
 
 // create a DNS record with given parameters
 func createDNSRecord(client *cfgo.CloudflareClient, zoneID, typ3 string, params map[string]any) {
-	record := map[string]any{
+	record := cfgo.DNSRecordRaw{
 		"type": typ3,
 	}
 	for k, v := range params {
 		record[k] = v
 	}
 
+	// validate
+	if err := record.Validate(); err != nil {
+		_stderr.Printf("invalid [%s] record with params %s: %s\n", typ3, jsonString(record), err)
+		os.Exit(1)
+	}
+
 	// create
 	if _, err := client.CreateDNSRecord(zoneID, record); err == nil {
 		_stdout.Printf("created [%s] record with params %s\n", typ3, jsonString(record))
@@ -399,13 +618,19 @@ func createDNSRecord(client *cfgo.CloudflareClient, zoneID, typ3 string, params
 
 // update a DNS record with given parameters
 func updateDNSRecord(client *cfgo.CloudflareClient, zoneID, recordID string, params map[string]any) {
-	record := map[string]any{
+	record := cfgo.DNSRecordRaw{
 		"id": recordID,
 	}
 	for k, v := range params {
 		record[k] = v
 	}
 
+	// validate
+	if err := record.Validate(); err != nil {
+		_stderr.Printf("invalid record with params %s: %s\n", jsonString(record), err)
+		os.Exit(1)
+	}
+
 	// update
 	if updated, err := client.UpdateDNSRecord(zoneID, recordID, record); err == nil {
 		if typ3, err := updated.Result.StringFor("type"); err == nil {
@@ -418,6 +643,36 @@ func updateDNSRecord(client *cfgo.CloudflareClient, zoneID, recordID string, par
 	}
 }
 
+// create or update a DNS record with given name, type, and parameters
+func upsertDNSRecord(client *cfgo.CloudflareClient, zoneID, typ3, name string, params map[string]any) {
+	record := cfgo.DNSRecordRaw{
+		"type": typ3,
+		"name": name,
+	}
+	for k, v := range params {
+		record[k] = v
+	}
+
+	// validate
+	if err := record.Validate(); err != nil {
+		_stderr.Printf("invalid [%s] record with params %s: %s\n", typ3, jsonString(record), err)
+		os.Exit(1)
+	}
+
+	// upsert
+	if upserted, err := client.UpsertDNSRecord(zoneID, record); err == nil {
+		if upserted.Created {
+			_stdout.Printf("created [%s] record %s with params %s\n", typ3, upserted.Result.GetID(), jsonString(record))
+		} else {
+			_stdout.Printf("updated [%s] record %s with params %s\n", typ3, upserted.Result.GetID(), jsonString(record))
+		}
+		os.Exit(0)
+	} else {
+		_stderr.Printf("failed to upsert [%s] record with params %s: %s\n", typ3, jsonString(record), err)
+		os.Exit(1)
+	}
+}
+
 // upsert all DNS records with given JSON file
 func upsertDNSRecords(client *cfgo.CloudflareClient, fpath string) {
 	processed := 0
@@ -434,6 +689,14 @@ func upsertDNSRecords(client *cfgo.CloudflareClient, fpath string) {
 						failed += 1
 
 						_stderr.Printf("zone id not found in record: %s", err)
+					} else if zoneID, err = client.ResolveZoneID(zoneID); err != nil {
+						failed += 1
+
+						_stderr.Printf("failed to resolve zone of record: %s\n", err)
+					} else if err = record.Validate(); err != nil {
+						failed += 1
+
+						_stderr.Printf("invalid [%s] record '%s': %s\n", record.GetType(), record.GetName(), err)
 					} else {
 						recordID, _ = record.StringFor("id") // record id can be null (when creating a new one)
 
@@ -490,6 +753,98 @@ func upsertDNSRecords(client *cfgo.CloudflareClient, fpath string) {
 	os.Exit(1)
 }
 
+// read DNS records from given JSON file
+func readRecordsFile(fpath string) (records []cfgo.DNSRecordRaw, err error) {
+	var bytes []byte
+	if bytes, err = os.ReadFile(fpath); err != nil {
+		return nil, fmt.Errorf("failed to read file: %s", err)
+	}
+	if bytes, err = standardizeJSON(bytes); err != nil {
+		return nil, fmt.Errorf("failed to standardize JSON file into JWCC: %s", err)
+	}
+	if err = json.Unmarshal(bytes, &records); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON file: %s", err)
+	}
+
+	return records, nil
+}
+
+// upsert all DNS records with given JSON file atomically (all or nothing, per zone)
+func batchDNSRecords(client *cfgo.CloudflareClient, fpath string) {
+	records, err := readRecordsFile(fpath)
+	if err != nil {
+		_stderr.Printf("%s\n", err)
+		os.Exit(1)
+	}
+
+	// validate all records before applying any of them
+	invalid := 0
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			invalid += 1
+
+			_stderr.Printf("invalid [%s] record '%s': %s\n", record.GetType(), record.GetName(), err)
+		}
+	}
+	if invalid > 0 {
+		_stderr.Printf("%d invalid DNS records (none applied)\n", invalid)
+		os.Exit(1)
+	}
+
+	// group records by zone, preserving their order
+	zoneIDs := []string{}
+	batches := map[string]*cfgo.DNSRecordsBatch{}
+	for _, record := range records {
+		zoneID, err := record.StringFor("zone_id")
+		if err != nil {
+			_stderr.Printf("zone id not found in record: %s\n", err)
+			os.Exit(1)
+		}
+		if zoneID, err = client.ResolveZoneID(zoneID); err != nil {
+			_stderr.Printf("failed to resolve zone of record: %s\n", err)
+			os.Exit(1)
+		}
+
+		if _, exists := batches[zoneID]; !exists {
+			zoneIDs = append(zoneIDs, zoneID)
+			batches[zoneID] = cfgo.NewDNSRecordsBatch()
+		}
+
+		if recordID, _ := record.StringFor("id"); recordID != "" {
+			batches[zoneID].Put(recordID, record)
+		} else {
+			batches[zoneID].Post(record)
+		}
+	}
+
+	failed := 0
+	for _, zoneID := range zoneIDs {
+		batch := batches[zoneID]
+
+		if applied, err := client.BatchDNSRecords(zoneID, batch); err == nil {
+			for _, record := range applied.Result.Puts {
+				name, _ := record.StringFor("name")
+				_stdout.Printf("updated [%s] record '%s'\n", record.GetType(), name)
+			}
+			for _, record := range applied.Result.Posts {
+				name, _ := record.StringFor("name")
+				_stdout.Printf("created [%s] record '%s'\n", record.GetType(), name)
+			}
+		} else {
+			failed += 1
+
+			_stderr.Printf("failed to apply %d DNS records for zone %s (none applied): %s\n", batch.Len(), zoneID, err)
+		}
+	}
+
+	_stderr.Printf("processed %d zones (%d errors)\n", len(zoneIDs), failed)
+
+	if failed == 0 {
+		os.Exit(0)
+	}
+	os.Exit(1)
+}
+
 // delete a DNS record with given record identifier
 func deleteDNSRecord(client *cfgo.CloudflareClient, zoneID, recordID string) {
 	if deleted, err := client.DeleteDNSRecord(zoneID, recordID); err == nil {
@@ -503,10 +858,92 @@ func deleteDNSRecord(client *cfgo.CloudflareClient, zoneID, recordID string) {
 	}
 }
 
-// filter parameters only (drop flags)
+// delete a DNS record with given name, type, and content (can be empty when there is only one record)
+func deleteDNSRecordByName(client *cfgo.CloudflareClient, zoneID, typ3, name, content string) {
+	if record, err := client.FindDNSRecord(zoneID, name, cfgo.DNSRecordType(typ3), content); err == nil {
+		deleteDNSRecord(client, zoneID, record.GetID())
+	} else {
+		_stderr.Printf("failed to find [%s] record '%s' for zone %s: %s\n", typ3, name, zoneID, err)
+
+		os.Exit(1)
+	}
+}
+
+// returns whether given parameters are for addressing a DNS record by its type and name (eg. `A ddns.example.com`),
+// not by its identifier (followed by `key=value` parameters)
+func addressedByName(params []string) bool {
+	return len(params) >= 2 && !strings.Contains(params[1], "=")
+}
+
+// export all DNS records of given zone in BIND config format, to stdout or given file
+func exportDNSRecords(client *cfgo.CloudflareClient, zoneID, fpath string) {
+	exported, err := client.ExportDNSRecords(zoneID)
+	if err != nil {
+		_stderr.Printf("failed to export DNS records for zone %s: %s\n", zoneID, err)
+
+		os.Exit(1)
+	}
+
+	if fpath == "" {
+		_, err = io.Copy(os.Stdout, exported)
+	} else {
+		var file *os.File
+		if file, err = os.Create(fpath); err == nil {
+			if _, err = io.Copy(file, exported); err == nil {
+				err = file.Close()
+			} else {
+				_ = file.Close()
+			}
+		}
+	}
+	_ = exported.Close()
+
+	if err == nil {
+		if fpath != "" {
+			_stderr.Printf("exported DNS records for zone %s to %s\n", zoneID, fpath)
+		}
+
+		os.Exit(0)
+	} else {
+		_stderr.Printf("failed to export DNS records for zone %s: %s\n", zoneID, err)
+
+		os.Exit(1)
+	}
+}
+
+// import DNS records of given zone from a zone file in BIND config format
+func importDNSRecords(client *cfgo.CloudflareClient, zoneID, fpath string, proxied bool) {
+	file, err := os.Open(fpath)
+	if err != nil {
+		_stderr.Printf("failed to open file: %s\n", err)
+
+		os.Exit(1)
+	}
+
+	imported, err := client.ImportDNSRecords(zoneID, file, &cfgo.DNSRecordsImportOptions{
+		Proxied: proxied,
+	})
+	_ = file.Close()
+
+	if err == nil {
+		_stdout.Printf("imported %d of %d DNS records for zone %s\n", imported.Result.RecsAdded, imported.Result.TotalRecordsParsed, zoneID)
+
+		os.Exit(0)
+	} else {
+		_stderr.Printf("failed to import DNS records for zone %s: %s\n", zoneID, err)
+
+		os.Exit(1)
+	}
+}
+
+// filter parameters only (drop flags, and values of short flags)
 func filterParams(args []string) (filtered []string) {
-	for _, arg := range args {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
 		if strings.HasPrefix(arg, "-") {
+			if slices.Contains(shortFlagsWithValues, arg) {
+				i++ // skip the value
+			}
 			continue
 		}
 		filtered = append(filtered, arg)
@@ -559,20 +996,38 @@ func convertKeyValueParams(params []string) (result map[string]any) {
 	return result
 }
 
+// flags for cloudflare clients
+type clientFlags struct {
+	verbose bool
+	record  string // cassette filepath for recording
+	replay  string // cassette filepath for replaying
+}
+
 // returns a new cloudflare client
-func getClient(verbose bool) (client *cfgo.CloudflareClient) {
+func getClient(flags clientFlags) (client *cfgo.CloudflareClient) {
 	var err error
 
+	if flags.replay != "" { // replay mode does not need credentials
+		var replayer *cfgo.Replayer
+		if replayer, err = cfgo.NewReplayer(flags.replay); err == nil {
+			client = cfgo.NewCloudflareClientWithAPIToken("replay", cfgo.WithTransport(replayer))
+			client.Verbose = flags.verbose
+
+			return client
+		}
+
+		_stderr.Fatalf("cloudflare client failed to replay: %s\n", err)
+	}
+
+	opts := []cfgo.Option{}
+	if flags.record != "" {
+		opts = append(opts, cfgo.WithTransport(cfgo.NewRecorder(flags.record, nil)))
+	}
+
 	var conf config
 	if conf, err = readConfig(); err == nil {
-		var email, apiKey *string
-		if email, apiKey, err = conf.GetEmailAndAPIKey(); err == nil {
-			if email != nil && apiKey != nil {
-				client = cfgo.NewCloudflareClient(*email, *apiKey)
-				client.Verbose = verbose
-			} else {
-				err = fmt.Errorf("`email` or `api_key` is missing")
-			}
+		if client, err = newClient(conf, opts...); err == nil {
+			client.Verbose = flags.verbose
 		}
 	}
 
@@ -583,13 +1038,37 @@ func getClient(verbose bool) (client *cfgo.CloudflareClient) {
 	return client
 }
 
+// returns a new cloudflare client with the credentials of given config (api token is preferred)
+func newClient(conf config, opts ...cfgo.Option) (client *cfgo.CloudflareClient, err error) {
+	var email, apiKey, apiToken *string
+	if email, apiKey, apiToken, err = conf.GetCredentials(); err == nil {
+		if apiToken != nil {
+			client = cfgo.NewCloudflareClientWithAPIToken(*apiToken, opts...)
+		} else if email != nil && apiKey != nil {
+			client = cfgo.NewCloudflareClient(*email, *apiKey, opts...)
+		} else {
+			err = fmt.Errorf("`api_token`, or `email` and `api_key` are missing")
+		}
+	}
+
+	return client, err
+}
+
 // run with arguments
 func run(application string, args []string) {
 	// handle flags
 	if flagExists(args, "-h", "--help") {
 		showHelp(application, nil)
 	}
-	verbose := flagExists(args, "-v", "--verbose")
+	atomic := flagExists(args, "-a", "--atomic")
+	flags := clientFlags{
+		verbose: flagExists(args, "-v", "--verbose"),
+	}
+	flags.record, _ = flagValue(args, "--record")
+	flags.replay, _ = flagValue(args, "--replay")
+	if flags.record != "" && flags.replay != "" {
+		showHelp(application, fmt.Errorf("'--record' and '--replay' cannot be used together"))
+	}
 
 	// handle commands
 	argsWithoutFlags := filterParams(args)
@@ -597,19 +1076,51 @@ func run(application string, args []string) {
 		cmd := argsWithoutFlags[0]
 		params := argsWithoutFlags[1:]
 		switch cmd {
-		case cmdZones: // list zones
-			listZones(getClient(verbose))
+		case cmdZones:
+			if len(params) == 0 { // list zones
+				listZones(getClient(flags))
+			}
+
+			switch params[0] {
+			case cmdCreate:
+				if len(params) >= 3 {
+					typ3, _ := flagValue(args, "--type")
+					createZone(getClient(flags), params[1], params[2], cfgo.ZoneType(typ3), flagExists(args, "-j", "--jump-start"))
+				} else {
+					showHelp(application, fmt.Errorf("account identifier or domain name was not given"))
+				}
+			case cmdDelete, cmdPause, cmdUnpause, cmdCheck:
+				if len(params) < 2 {
+					showHelp(application, fmt.Errorf("zone identifier or domain name was not given"))
+				}
+
+				client := getClient(flags)
+				zoneID := resolveExactZoneID(client, params[1]) // no suffix match for zone-level changes
+
+				switch params[0] {
+				case cmdDelete:
+					deleteZone(client, zoneID)
+				case cmdPause, cmdUnpause:
+					pauseZone(client, zoneID, params[0] == cmdPause)
+				case cmdCheck:
+					checkZoneActivation(client, zoneID)
+				}
+			}
+
+			showHelp(application, fmt.Errorf("'%s %s' is not a supported command.", cmd, params[0]))
 		case cmdRecords:
 			if len(params) >= 1 {
-				listDNSRecords(getClient(verbose), params[0])
+				client := getClient(flags)
+				listDNSRecords(client, resolveZoneID(client, params[0]))
 			} else {
-				showHelp(application, fmt.Errorf("zone identifier was not given"))
+				showHelp(application, fmt.Errorf("zone identifier or domain name was not given"))
 			}
 		case cmdCreate:
 			if len(params) >= 3 {
 				kvs := convertKeyValueParams(params[2:])
 				if len(kvs) > 0 {
-					createDNSRecord(getClient(verbose), params[0], params[1], kvs)
+					client := getClient(flags)
+					createDNSRecord(client, resolveZoneID(client, params[0]), params[1], kvs)
 				} else {
 					showHelp(application, fmt.Errorf("parameters for a new DNS record were not given"))
 				}
@@ -617,10 +1128,19 @@ func run(application string, args []string) {
 				showHelp(application, fmt.Errorf("essential parameters were not given"))
 			}
 		case cmdUpdate:
-			if len(params) >= 3 {
+			if len(params) >= 4 && addressedByName(params[1:]) { // by type and name
+				kvs := convertKeyValueParams(params[3:])
+				if len(kvs) > 0 {
+					client := getClient(flags)
+					upsertDNSRecord(client, resolveZoneID(client, params[0]), params[1], params[2], kvs)
+				} else {
+					showHelp(application, fmt.Errorf("parameters for an updated DNS record were not given"))
+				}
+			} else if len(params) >= 3 { // by record identifier
 				kvs := convertKeyValueParams(params[2:])
 				if len(kvs) > 0 {
-					updateDNSRecord(getClient(verbose), params[0], params[1], kvs)
+					client := getClient(flags)
+					updateDNSRecord(client, resolveZoneID(client, params[0]), params[1], kvs)
 				} else {
 					showHelp(application, fmt.Errorf("parameters for an updated DNS record were not given"))
 				}
@@ -629,15 +1149,42 @@ func run(application string, args []string) {
 			}
 		case cmdBatch:
 			if len(params) >= 1 {
-				upsertDNSRecords(getClient(verbose), params[0])
+				if atomic {
+					batchDNSRecords(getClient(flags), params[0])
+				} else {
+					upsertDNSRecords(getClient(flags), params[0])
+				}
 			} else {
 				showHelp(application, fmt.Errorf("JSON filepath was not given"))
 			}
 		case cmdDelete:
+			if len(params) >= 3 && addressedByName(params[1:]) { // by type and name
+				content := ""
+				if v, exists := convertKeyValueParams(params[3:])["content"]; exists {
+					content = fmt.Sprintf("%v", v)
+				}
+				client := getClient(flags)
+				deleteDNSRecordByName(client, resolveZoneID(client, params[0]), params[1], params[2], content)
+			} else if len(params) >= 2 { // by record identifier
+				client := getClient(flags)
+				deleteDNSRecord(client, resolveZoneID(client, params[0]), params[1])
+			} else {
+				showHelp(application, fmt.Errorf("zone (identifier or domain name) or DNS record identifier was not given"))
+			}
+		case cmdExport:
+			if len(params) >= 1 {
+				client := getClient(flags)
+				fpath, _ := flagValueWithShort(args, "-o", "--output")
+				exportDNSRecords(client, resolveZoneID(client, params[0]), fpath)
+			} else {
+				showHelp(application, fmt.Errorf("zone identifier or domain name was not given"))
+			}
+		case cmdImport:
 			if len(params) >= 2 {
-				deleteDNSRecord(getClient(verbose), params[0], params[1])
+				client := getClient(flags)
+				importDNSRecords(client, resolveZoneID(client, params[0]), params[1], flagExists(args, "-p", "--proxied"))
 			} else {
-				showHelp(application, fmt.Errorf("zone identifier or DNS record identifier was not given"))
+				showHelp(application, fmt.Errorf("zone (identifier or domain name) or zone file path was not given"))
 			}
 		case cmdGenerate:
 			showSampleRecords()
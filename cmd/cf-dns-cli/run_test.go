@@ -0,0 +1,71 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cfgo "github.com/meinside/cloudflare-go"
+)
+
+func TestNewClientFromConfig(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":[]}`))
+	}))
+	defer server.Close()
+
+	for name, test := range map[string]struct {
+		config   string
+		expected map[string]string
+	}{
+		"api token": {
+			config: `{
+  // scoped api token (preferred)
+  "api_token": "test-token",
+  "email": "user@example.com",
+  "api_key": "test-api-key",
+}`,
+			expected: map[string]string{"Authorization": "Bearer test-token", "X-Auth-Email": "", "X-Auth-Key": ""},
+		},
+		"email and api key": {
+			config:   `{"email": "user@example.com", "api_key": "test-api-key"}`,
+			expected: map[string]string{"X-Auth-Email": "user@example.com", "X-Auth-Key": "test-api-key", "Authorization": ""},
+		},
+	} {
+		// config file in $XDG_CONFIG_HOME/cf-dns-cli/
+		configHome := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", configHome)
+		if err := os.MkdirAll(filepath.Join(configHome, applicationName), 0o700); err != nil {
+			t.Fatalf("[%s] failed to create config dir: %s", name, err)
+		}
+		if err := os.WriteFile(filepath.Join(configHome, applicationName, configFilename), []byte(test.config), 0o600); err != nil {
+			t.Fatalf("[%s] failed to write config file: %s", name, err)
+		}
+
+		conf, err := readConfig()
+		if err != nil {
+			t.Fatalf("[%s] failed to read config: %s", name, err)
+		}
+		client, err := newClient(conf, cfgo.WithBaseURL(server.URL))
+		if err != nil {
+			t.Fatalf("[%s] failed to create client: %s", name, err)
+		}
+		if _, err := client.ListZones(); err != nil {
+			t.Fatalf("[%s] failed to list zones: %s", name, err)
+		}
+		for header, value := range test.expected {
+			if received.Get(header) != value {
+				t.Errorf("[%s] expected header %s: '%s', but got '%s'", name, header, value, received.Get(header))
+			}
+		}
+	}
+
+	// no credentials
+	if _, err := newClient(config{}); err == nil {
+		t.Errorf("expected an error without credentials")
+	}
+}
@@ -1,14 +1,40 @@
 package cfgo
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
 )
 
-// ListZones returns all zones.
-func (c *CloudflareClient) ListZones() (response ResponseZones, err error) {
+// ErrRecordNotFound is returned when no DNS record matches given name, type, (and content).
+//
+// `IsNotFound` also returns true for this error.
+var ErrRecordNotFound = errors.New("no DNS record found")
+
+// ListDNSRecords returns DNS records (of a page) for given zone identifier and queries.
+//
+// Use `ListAllDNSRecords` or `IterateDNSRecords` for retrieving DNS records of all pages.
+//
+// Queries can be built with `DNSRecordFilter`.
+//
+// The type of each `DNSRecordRaw` value in `Result` can be determined with `GetType()` function,
+// then it can be converted into the determined struct type with `Into()` function.
+//
+// https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-list-dns-records
+func (c *CloudflareClient) ListDNSRecords(zoneID string, queries map[string]any) (response ResponseDNSRecords, err error) {
+	return c.ListDNSRecordsContext(context.Background(), zoneID, queries)
+}
+
+// ListDNSRecordsContext returns DNS records for given zone identifier and queries, with given context.
+func (c *CloudflareClient) ListDNSRecordsContext(ctx context.Context, zoneID string, queries map[string]any) (response ResponseDNSRecords, err error) {
 	var bytes []byte
-	bytes, err = c.get(fmt.Sprintf("zones"), nil)
+	bytes, err = c.get(ctx, fmt.Sprintf("zones/%s/dns_records", zoneID), queries)
 
 	if err == nil {
 		err = json.Unmarshal(bytes, &response)
@@ -17,15 +43,17 @@ func (c *CloudflareClient) ListZones() (response ResponseZones, err error) {
 	return response, err
 }
 
-// ListDNSRecords returns DNS records for given zone identifier and queries.
-//
-// The type of each `DNSRecordRaw` value in `Result` can be determined with `GetType()` function,
-// then it can be converted into the determined struct type with `Into()` function.
+// GetDNSRecord returns a DNS record with given identifiers.
 //
-// https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-list-dns-records
-func (c *CloudflareClient) ListDNSRecords(zoneID string, queries map[string]any) (response ResponseDNSRecords, err error) {
+// https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-dns-record-details
+func (c *CloudflareClient) GetDNSRecord(zoneID, recordID string) (response ResponseDNSRecordDetails, err error) {
+	return c.GetDNSRecordContext(context.Background(), zoneID, recordID)
+}
+
+// GetDNSRecordContext returns a DNS record with given identifiers, with given context.
+func (c *CloudflareClient) GetDNSRecordContext(ctx context.Context, zoneID, recordID string) (response ResponseDNSRecordDetails, err error) {
 	var bytes []byte
-	bytes, err = c.get(fmt.Sprintf("zones/%s/dns_records", zoneID), queries)
+	bytes, err = c.get(ctx, fmt.Sprintf("zones/%s/dns_records/%s", zoneID, recordID), nil)
 
 	if err == nil {
 		err = json.Unmarshal(bytes, &response)
@@ -36,12 +64,17 @@ func (c *CloudflareClient) ListDNSRecords(zoneID string, queries map[string]any)
 
 // CreateDNSRecord creates a DNS record with given parameters.
 //
-// Generate a new record with NewDNSRecord* functions.
+// Generate a new record with NewDNSRecord* functions, or use a DNSRecordRaw.
 //
 // https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-create-dns-record
-func (c *CloudflareClient) CreateDNSRecord(zoneID string, newOne any) (response ResponseDNSRecordCreation, err error) {
+func (c *CloudflareClient) CreateDNSRecord(zoneID string, newOne DNSRecord) (response ResponseDNSRecordCreation, err error) {
+	return c.CreateDNSRecordContext(context.Background(), zoneID, newOne)
+}
+
+// CreateDNSRecordContext creates a DNS record with given parameters, with given context.
+func (c *CloudflareClient) CreateDNSRecordContext(ctx context.Context, zoneID string, newOne DNSRecord) (response ResponseDNSRecordCreation, err error) {
 	var bytes []byte
-	bytes, err = c.post(fmt.Sprintf("zones/%s/dns_records", zoneID), newOne)
+	bytes, err = c.post(ctx, fmt.Sprintf("zones/%s/dns_records", zoneID), newOne)
 
 	if err == nil {
 		err = json.Unmarshal(bytes, &response)
@@ -54,8 +87,13 @@ func (c *CloudflareClient) CreateDNSRecord(zoneID string, newOne any) (response
 //
 // https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-delete-dns-record
 func (c *CloudflareClient) DeleteDNSRecord(zoneID, recordID string) (response ResponseDNSRecordDeletion, err error) {
+	return c.DeleteDNSRecordContext(context.Background(), zoneID, recordID)
+}
+
+// DeleteDNSRecordContext deletes a DNS record with given identifiers, with given context.
+func (c *CloudflareClient) DeleteDNSRecordContext(ctx context.Context, zoneID, recordID string) (response ResponseDNSRecordDeletion, err error) {
 	var bytes []byte
-	bytes, err = c.delete(fmt.Sprintf("zones/%s/dns_records/%s", zoneID, recordID), nil)
+	bytes, err = c.delete(ctx, fmt.Sprintf("zones/%s/dns_records/%s", zoneID, recordID), nil)
 
 	if err == nil {
 		err = json.Unmarshal(bytes, &response)
@@ -66,12 +104,82 @@ func (c *CloudflareClient) DeleteDNSRecord(zoneID, recordID string) (response Re
 
 // UpdateDNSRecord updates a DNS record with given parameters.
 //
-// Updated record can be generated with NewDNSRecord* functions.
+// Updated record can be generated with NewDNSRecord* functions, or be a DNSRecordRaw.
 //
 // https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-update-dns-record
-func (c *CloudflareClient) UpdateDNSRecord(zoneID, recordID string, updatedOne any) (response ResponseDNSRecordUpdate, err error) {
+func (c *CloudflareClient) UpdateDNSRecord(zoneID, recordID string, updatedOne DNSRecord) (response ResponseDNSRecordUpdate, err error) {
+	return c.UpdateDNSRecordContext(context.Background(), zoneID, recordID, updatedOne)
+}
+
+// UpdateDNSRecordContext updates a DNS record with given parameters, with given context.
+func (c *CloudflareClient) UpdateDNSRecordContext(ctx context.Context, zoneID, recordID string, updatedOne DNSRecord) (response ResponseDNSRecordUpdate, err error) {
+	var bytes []byte
+	bytes, err = c.put(ctx, fmt.Sprintf("zones/%s/dns_records/%s", zoneID, recordID), updatedOne)
+
+	if err == nil {
+		err = json.Unmarshal(bytes, &response)
+	}
+
+	return response, err
+}
+
+// PatchDNSRecord updates only the given fields of a DNS record.
+//
+// Nested fields can be given with dotted keys, eg. `data.priority` or `settings.flatten_cname`.
+//
+// https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-patch-dns-record
+func (c *CloudflareClient) PatchDNSRecord(zoneID, recordID string, fields DNSRecordPatch) (response ResponseDNSRecordPatch, err error) {
+	return c.PatchDNSRecordContext(context.Background(), zoneID, recordID, fields)
+}
+
+// PatchDNSRecordContext updates only the given fields of a DNS record, with given context.
+func (c *CloudflareClient) PatchDNSRecordContext(ctx context.Context, zoneID, recordID string, fields DNSRecordPatch) (response ResponseDNSRecordPatch, err error) {
+	var bytes []byte
+	bytes, err = c.patch(ctx, fmt.Sprintf("zones/%s/dns_records/%s", zoneID, recordID), fields)
+
+	if err == nil {
+		err = json.Unmarshal(bytes, &response)
+	}
+
+	return response, err
+}
+
+// ExportDNSRecords exports all DNS records of a zone in BIND config (RFC 1035 zone file) format.
+//
+// The returned reader should be closed by the caller.
+//
+// https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-export-dns-records
+func (c *CloudflareClient) ExportDNSRecords(zoneID string) (exported io.ReadCloser, err error) {
+	return c.ExportDNSRecordsContext(context.Background(), zoneID)
+}
+
+// ExportDNSRecordsContext exports all DNS records of a zone in BIND config format, with given context.
+//
+// Reading the returned reader is also bound to given context.
+func (c *CloudflareClient) ExportDNSRecordsContext(ctx context.Context, zoneID string) (exported io.ReadCloser, err error) {
+	return c.getStream(ctx, fmt.Sprintf("zones/%s/dns_records/export", zoneID), nil)
+}
+
+// ImportDNSRecords imports DNS records from a zone file in BIND config (RFC 1035 zone file) format.
+//
+// Options can be nil for defaults.
+//
+// https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-import-dns-records
+func (c *CloudflareClient) ImportDNSRecords(zoneID string, zoneFile io.Reader, opts *DNSRecordsImportOptions) (response ResponseDNSRecordsImport, err error) {
+	return c.ImportDNSRecordsContext(context.Background(), zoneID, zoneFile, opts)
+}
+
+// ImportDNSRecordsContext imports DNS records from a zone file in BIND config format, with given context.
+func (c *CloudflareClient) ImportDNSRecordsContext(ctx context.Context, zoneID string, zoneFile io.Reader, opts *DNSRecordsImportOptions) (response ResponseDNSRecordsImport, err error) {
+	fields := map[string]string{}
+	if opts != nil {
+		fields["proxied"] = strconv.FormatBool(opts.Proxied)
+	}
+
 	var bytes []byte
-	bytes, err = c.put(fmt.Sprintf("zones/%s/dns_records/%s", zoneID, recordID), updatedOne)
+	bytes, err = c.postMultipart(ctx, fmt.Sprintf("zones/%s/dns_records/import", zoneID), fields, map[string]multipartFile{
+		"file": {filename: "zone.txt", reader: zoneFile},
+	})
 
 	if err == nil {
 		err = json.Unmarshal(bytes, &response)
@@ -79,3 +187,146 @@ func (c *CloudflareClient) UpdateDNSRecord(zoneID, recordID string, updatedOne a
 
 	return response, err
 }
+
+// FindDNSRecords returns all DNS records with given name and type. (no error when there is none)
+//
+// Name can be fully qualified (eg. `www.example.com`), relative to the zone (eg. `www`), or `@` for the zone apex.
+func (c *CloudflareClient) FindDNSRecords(zoneID, name string, typ3 DNSRecordType) (records []DNSRecordRaw, err error) {
+	return c.FindDNSRecordsContext(context.Background(), zoneID, name, typ3)
+}
+
+// FindDNSRecordsContext returns all DNS records with given name and type, with given context.
+func (c *CloudflareClient) FindDNSRecordsContext(ctx context.Context, zoneID, name string, typ3 DNSRecordType) (records []DNSRecordRaw, err error) {
+	// names are stored fully qualified, so `@` and relative names are qualified with the zone name
+	var zoneName string
+	if zoneName, err = c.zoneName(ctx, zoneID); err != nil {
+		return nil, err
+	}
+
+	filter := NewDNSRecordFilter().
+		Name(FilterExact, qualifiedName(name, zoneName)).
+		Type(typ3)
+
+	return c.ListAllDNSRecordsContext(ctx, zoneID, filter.Queries())
+}
+
+// FindDNSRecord returns the only DNS record with given name, type, and content. (content can be empty for any)
+//
+// Returns `ErrRecordNotFound` when there is none, or an `*AmbiguousRecordsError` when there are many.
+func (c *CloudflareClient) FindDNSRecord(zoneID, name string, typ3 DNSRecordType, content string) (record DNSRecordRaw, err error) {
+	return c.FindDNSRecordContext(context.Background(), zoneID, name, typ3, content)
+}
+
+// FindDNSRecordContext returns the only DNS record with given name, type, and content, with given context.
+func (c *CloudflareClient) FindDNSRecordContext(ctx context.Context, zoneID, name string, typ3 DNSRecordType, content string) (record DNSRecordRaw, err error) {
+	var records []DNSRecordRaw
+	if records, err = c.FindDNSRecordsContext(ctx, zoneID, name, typ3); err != nil {
+		return nil, err
+	}
+
+	matched := records
+	if content != "" {
+		matched = withContent(records, content)
+	}
+
+	switch len(matched) {
+	case 0:
+		return nil, fmt.Errorf("%w for [%s] '%s'", ErrRecordNotFound, typ3, name)
+	case 1:
+		return matched[0], nil
+	default:
+		return nil, &AmbiguousRecordsError{Name: name, Type: typ3, Content: content, Records: records}
+	}
+}
+
+// UpsertDNSRecord creates a DNS record, or updates the existing one with the same name and type.
+//
+// When there are multiple records with the same name and type (eg. round-robin A records),
+// the one with the same content is updated, or a new one is added to them if there is none.
+// An `*AmbiguousRecordsError` is returned if given record has no content for choosing one of them.
+func (c *CloudflareClient) UpsertDNSRecord(zoneID string, record DNSRecord) (response ResponseDNSRecordUpsert, err error) {
+	return c.UpsertDNSRecordContext(context.Background(), zoneID, record)
+}
+
+// UpsertDNSRecordContext creates a DNS record, or updates the existing one with the same name and type, with given context.
+func (c *CloudflareClient) UpsertDNSRecordContext(ctx context.Context, zoneID string, record DNSRecord) (response ResponseDNSRecordUpsert, err error) {
+	var records []DNSRecordRaw
+	if records, err = c.FindDNSRecordsContext(ctx, zoneID, record.GetName(), record.GetType()); err != nil {
+		return response, err
+	}
+
+	var existing DNSRecordRaw
+	switch len(records) {
+	case 0: // create
+	case 1:
+		existing = records[0]
+	default:
+		if record.GetContent() == "" {
+			return response, &AmbiguousRecordsError{
+				Name:    record.GetName(),
+				Type:    record.GetType(),
+				Records: records,
+			}
+		}
+		if matched := withContent(records, record.GetContent()); len(matched) > 0 {
+			existing = matched[0]
+		} // or create a new member of the record set
+	}
+
+	if existing == nil {
+		var created ResponseDNSRecordCreation
+		if created, err = c.CreateDNSRecordContext(ctx, zoneID, record); err == nil {
+			response = ResponseDNSRecordUpsert{
+				ResponseCommon: created.ResponseCommon,
+				Result:         created.Result,
+				Created:        true,
+			}
+		}
+	} else {
+		var updated ResponseDNSRecordUpdate
+		if updated, err = c.UpdateDNSRecordContext(ctx, zoneID, existing.GetID(), record); err == nil {
+			response = ResponseDNSRecordUpsert{
+				ResponseCommon: updated.ResponseCommon,
+				Result:         updated.Result,
+			}
+		}
+	}
+
+	return response, err
+}
+
+// record types whose contents are hostnames (compared case-insensitively, without a trailing dot)
+var hostnameContentTypes = []DNSRecordType{CNAME, MX, NS, PTR}
+
+// returns records which have the same content as given one
+func withContent(records []DNSRecordRaw, content string) (matched []DNSRecordRaw) {
+	for _, record := range records {
+		if sameContent(record.GetType(), record.GetContent(), content) {
+			matched = append(matched, record)
+		}
+	}
+
+	return matched
+}
+
+// compares contents of DNS records with given type:
+// IP addresses by their values, hostnames case-insensitively without a trailing dot, and others exactly
+func sameContent(typ3 DNSRecordType, a, b string) bool {
+	if a == b {
+		return true
+	}
+	if a == "" || b == "" {
+		return false
+	}
+
+	switch {
+	case typ3 == A || typ3 == AAAA:
+		addrA, errA := netip.ParseAddr(a)
+		addrB, errB := netip.ParseAddr(b)
+		return errA == nil && errB == nil && addrA == addrB
+	case slices.Contains(hostnameContentTypes, typ3):
+		return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+	}
+
+	return false
+}
@@ -0,0 +1,114 @@
+package cfgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// DNSRecordsBatch is a typed builder of batched DNS record operations.
+//
+// Operations are applied atomically in the order of: deletes, patches, puts, and posts.
+//
+// https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-batch-dns-records
+type DNSRecordsBatch struct {
+	deletes []batchRecord
+	patches []batchRecord
+	puts    []batchRecord
+	posts   []batchRecord
+}
+
+// batched record with its identifier
+type batchRecord struct {
+	id     string
+	record any // `DNSRecord` for puts and posts, `DNSRecordPatch` for patches
+}
+
+// MarshalJSON encodes the record with its identifier.
+func (r batchRecord) MarshalJSON() ([]byte, error) {
+	fields := map[string]any{}
+	if r.record != nil {
+		encoded, err := json.Marshal(r.record)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(encoded, &fields); err != nil {
+			return nil, fmt.Errorf("failed to convert record into fields: %s", err)
+		}
+	}
+	if r.id != "" {
+		fields["id"] = r.id
+	}
+
+	return json.Marshal(fields)
+}
+
+// NewDNSRecordsBatch returns a new, empty batch.
+func NewDNSRecordsBatch() *DNSRecordsBatch {
+	return &DNSRecordsBatch{}
+}
+
+// Delete adds a deletion of the record with given identifier.
+func (b *DNSRecordsBatch) Delete(recordID string) *DNSRecordsBatch {
+	b.deletes = append(b.deletes, batchRecord{id: recordID})
+	return b
+}
+
+// Patch adds a partial update of the record with given identifier.
+func (b *DNSRecordsBatch) Patch(recordID string, fields DNSRecordPatch) *DNSRecordsBatch {
+	b.patches = append(b.patches, batchRecord{id: recordID, record: fields})
+	return b
+}
+
+// Put adds an overwrite of the record with given identifier.
+func (b *DNSRecordsBatch) Put(recordID string, record DNSRecord) *DNSRecordsBatch {
+	b.puts = append(b.puts, batchRecord{id: recordID, record: record})
+	return b
+}
+
+// Post adds a creation of given record.
+func (b *DNSRecordsBatch) Post(record DNSRecord) *DNSRecordsBatch {
+	b.posts = append(b.posts, batchRecord{record: record})
+	return b
+}
+
+// Len returns the number of operations in the batch.
+func (b *DNSRecordsBatch) Len() int {
+	return len(b.deletes) + len(b.patches) + len(b.puts) + len(b.posts)
+}
+
+// MarshalJSON encodes the batch as a request body.
+func (b *DNSRecordsBatch) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Deletes []batchRecord `json:"deletes,omitempty"`
+		Patches []batchRecord `json:"patches,omitempty"`
+		Puts    []batchRecord `json:"puts,omitempty"`
+		Posts   []batchRecord `json:"posts,omitempty"`
+	}{
+		Deletes: b.deletes,
+		Patches: b.patches,
+		Puts:    b.puts,
+		Posts:   b.posts,
+	})
+}
+
+// BatchDNSRecords applies given batched operations atomically.
+//
+// If any of the operations fails, none of them will be applied.
+//
+// https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-batch-dns-records
+func (c *CloudflareClient) BatchDNSRecords(zoneID string, batch *DNSRecordsBatch) (response ResponseDNSRecordsBatch, err error) {
+	return c.BatchDNSRecordsContext(context.Background(), zoneID, batch)
+}
+
+// BatchDNSRecordsContext applies given batched operations atomically, with given context.
+func (c *CloudflareClient) BatchDNSRecordsContext(ctx context.Context, zoneID string, batch *DNSRecordsBatch) (response ResponseDNSRecordsBatch, err error) {
+	var bytes []byte
+	bytes, err = c.post(ctx, fmt.Sprintf("zones/%s/dns_records/batch", zoneID), batch)
+
+	if err == nil {
+		err = json.Unmarshal(bytes, &response)
+	}
+
+	return response, err
+}
@@ -0,0 +1,78 @@
+package cfgo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/meinside/cloudflare-go/cfgotest"
+)
+
+func TestDNSRecordsBatch(t *testing.T) {
+	batch := NewDNSRecordsBatch().
+		Delete("record-1").
+		Patch("record-2", NewDNSRecordPatch().Set("data.priority", 10)).
+		Put("record-3", NewDNSRecordTXT("txt.example.com", "updated")).
+		Post(NewDNSRecordA("a.example.com", "1.2.3.4"))
+
+	if batch.Len() != 4 {
+		t.Errorf("expected 4 operations, but got %d", batch.Len())
+	}
+
+	if encoded, err := json.Marshal(batch); err != nil {
+		t.Errorf("failed to encode batch: %s", err)
+	} else if expected := `{"deletes":[{"id":"record-1"}],"patches":[{"data":{"priority":10},"id":"record-2"}],"puts":[{"content":"updated","id":"record-3","meta":{},"name":"txt.example.com","type":"TXT"}],"posts":[{"content":"1.2.3.4","meta":{},"name":"a.example.com","proxied":false,"type":"A"}]}`; string(encoded) != expected {
+		t.Errorf("expected '%s', but got '%s'", expected, string(encoded))
+	}
+}
+
+func TestBatchDNSRecords(t *testing.T) {
+	server := cfgotest.NewServer()
+	defer server.Close()
+
+	zoneID := server.AddZone("example.com")
+	deleted := server.AddDNSRecord(zoneID, map[string]any{"type": "A", "name": "old.example.com", "content": "10.0.0.1"})
+	patched := server.AddDNSRecord(zoneID, map[string]any{"type": "MX", "name": "example.com", "content": "mx.example.com", "priority": 10})
+	put := server.AddDNSRecord(zoneID, map[string]any{"type": "TXT", "name": "txt.example.com", "content": "original"})
+	client := NewCloudflareClientWithAPIToken("test-token", WithBaseURL(server.BaseURL()))
+
+	batch := NewDNSRecordsBatch().
+		Delete(deleted).
+		Patch(patched, NewDNSRecordPatch().Set("priority", 20)).
+		Put(put, NewDNSRecordTXT("txt.example.com", "updated")).
+		Post(NewDNSRecordCNAME("www.example.com", "example.com"))
+
+	applied, err := client.BatchDNSRecords(zoneID, batch)
+	if err != nil {
+		t.Fatalf("failed to apply batch: %s", err)
+	}
+
+	posts, puts, patches, err := applied.TypedResults()
+	if err != nil {
+		t.Fatalf("failed to convert results: %s", err)
+	}
+	if len(posts) != 1 || len(puts) != 1 || len(patches) != 1 {
+		t.Fatalf("expected 1 result for each operation, but got %d posts, %d puts, and %d patches", len(posts), len(puts), len(patches))
+	}
+	if cname, ok := posts[0].(*DNSRecordCNAME); !ok || cname.Content != "example.com" {
+		t.Errorf("expected a posted CNAME record, but got %+v", posts[0])
+	}
+	if txt, ok := puts[0].(*DNSRecordTXT); !ok || txt.Content != "updated" || txt.ID != put {
+		t.Errorf("expected a put TXT record, but got %+v", puts[0])
+	}
+	if mx, ok := patches[0].(*DNSRecordMX); !ok || mx.ID != patched {
+		t.Errorf("expected a patched MX record, but got %+v", patches[0])
+	}
+	if records := server.DNSRecords(zoneID); len(records) != 3 {
+		t.Errorf("expected 3 records after the batch, but got %d", len(records))
+	}
+
+	// nothing is applied on failure
+	if _, err := client.BatchDNSRecords(zoneID, NewDNSRecordsBatch().
+		Post(NewDNSRecordA("new.example.com", "10.0.0.2")).
+		Delete("non-existent-record")); err == nil {
+		t.Errorf("expected a failed batch")
+	}
+	if records := server.DNSRecords(zoneID); len(records) != 3 {
+		t.Errorf("expected 3 records after the failed batch, but got %d", len(records))
+	}
+}
@@ -0,0 +1,141 @@
+package cfgo
+
+import (
+	"errors"
+	"slices"
+)
+
+// records which can be proxied, and have settings (A, AAAA, and CNAME records)
+type proxiableRecord interface {
+	proxiable() (proxied *bool, settings **DNSRecordSettings)
+}
+
+// RecordBuilder is a generic, fluent builder of DNS records in any type.
+//
+//	record, err := cfgo.NewRecordBuilder(cfgo.NewDNSRecordSRV("_sip._tcp.example.com", 5060, 10, "_tcp", "_sip", "sip.example.com", 5)).
+//		TTL(3600).
+//		AddTag("owner:voip-team").
+//		With(func(r *cfgo.DNSRecordSRV) {
+//			r.Data.Weight = 20
+//		}).
+//		Build()
+type RecordBuilder[R DNSRecord] struct {
+	record R
+	errors []error
+}
+
+// NewRecordBuilder returns a new builder for given record. (eg. created with NewDNSRecord* functions)
+func NewRecordBuilder[R DNSRecord](record R) *RecordBuilder[R] {
+	return &RecordBuilder[R]{
+		record: record,
+	}
+}
+
+// sets a common value: as a key of raw record, or with given function for typed ones
+func (b *RecordBuilder[R]) set(key string, value any, apply func(c *DNSRecordCommon)) *RecordBuilder[R] {
+	if raw, ok := any(b.record).(DNSRecordRaw); ok {
+		raw[key] = value
+	} else {
+		apply(b.record.Common())
+	}
+	return b
+}
+
+// adds an error for a field which is not supported by the type of record
+func (b *RecordBuilder[R]) unsupported(field string) *RecordBuilder[R] {
+	b.errors = append(b.errors, &ValidationError{
+		Type:    b.record.GetType(),
+		Field:   field,
+		Message: "is not supported",
+	})
+	return b
+}
+
+// ID sets the `id` value of DNS record.
+func (b *RecordBuilder[R]) ID(recordID string) *RecordBuilder[R] {
+	return b.set("id", recordID, func(c *DNSRecordCommon) { c.ID = recordID })
+}
+
+// ZoneID sets the `zone_id` value of DNS record.
+func (b *RecordBuilder[R]) ZoneID(zoneID string) *RecordBuilder[R] {
+	return b.set("zone_id", zoneID, func(c *DNSRecordCommon) { c.ZoneID = zoneID })
+}
+
+// Name sets the `name` value of DNS record.
+func (b *RecordBuilder[R]) Name(name string) *RecordBuilder[R] {
+	return b.set("name", name, func(c *DNSRecordCommon) { c.Name = name })
+}
+
+// Content sets the `content` value of DNS record.
+func (b *RecordBuilder[R]) Content(content string) *RecordBuilder[R] {
+	return b.set("content", content, func(c *DNSRecordCommon) { c.Content = content })
+}
+
+// Comment sets the `comment` value of DNS record.
+func (b *RecordBuilder[R]) Comment(comment string) *RecordBuilder[R] {
+	return b.set("comment", comment, func(c *DNSRecordCommon) { c.Comment = comment })
+}
+
+// TTL sets the `ttl` value of DNS record. (1 for automatic)
+func (b *RecordBuilder[R]) TTL(ttl int) *RecordBuilder[R] {
+	return b.set("ttl", ttl, func(c *DNSRecordCommon) { c.TTL = ttl })
+}
+
+// Tags sets (replaces) the `tags` value of DNS record.
+func (b *RecordBuilder[R]) Tags(tags ...string) *RecordBuilder[R] {
+	tags = slices.Clone(tags)
+	return b.set("tags", tags, func(c *DNSRecordCommon) { c.Tags = tags })
+}
+
+// AddTag adds a tag (eg. `name:value`) to the `tags` value of DNS record.
+func (b *RecordBuilder[R]) AddTag(tag string) *RecordBuilder[R] {
+	return b.Tags(append(b.record.GetTags(), tag)...)
+}
+
+// Proxied sets the `proxied` value of DNS record. (only for A, AAAA, and CNAME records)
+func (b *RecordBuilder[R]) Proxied(proxied bool) *RecordBuilder[R] {
+	switch r := any(b.record).(type) {
+	case proxiableRecord:
+		p, _ := r.proxiable()
+		*p = proxied
+	case DNSRecordRaw:
+		r["proxied"] = proxied
+	default:
+		return b.unsupported("proxied")
+	}
+	return b
+}
+
+// Settings sets the `settings` value of DNS record. (only for A, AAAA, and CNAME records)
+func (b *RecordBuilder[R]) Settings(settings DNSRecordSettings) *RecordBuilder[R] {
+	switch r := any(b.record).(type) {
+	case proxiableRecord:
+		_, s := r.proxiable()
+		*s = &settings
+	case DNSRecordRaw:
+		r["settings"] = settings
+	default:
+		return b.unsupported("settings")
+	}
+	return b
+}
+
+// With applies given function to the record, for setting type-specific values. (eg. `Data` fields)
+func (b *RecordBuilder[R]) With(fn func(r R)) *RecordBuilder[R] {
+	fn(b.record)
+	return b
+}
+
+// Record returns the record being built, without validation.
+func (b *RecordBuilder[R]) Record() R {
+	return b.record
+}
+
+// Build returns the built record after validating it.
+func (b *RecordBuilder[R]) Build() (record R, err error) {
+	if err = errors.Join(append(slices.Clone(b.errors), b.record.Validate())...); err != nil {
+		return record, err
+	}
+
+	return b.record, nil
+}
@@ -0,0 +1,82 @@
+package cfgo
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRecordBuilder(t *testing.T) {
+	// typed record
+	srv, err := NewRecordBuilder(NewDNSRecordSRV("_sip._tcp.example.com", 5060, 10, "_tcp", "_sip", "sip.example.com", 5)).
+		ID("record-id").
+		ZoneID("zone-id").
+		TTL(3600).
+		Comment("testing").
+		Tags("owner:voip-team").
+		AddTag("env:test").
+		With(func(r *DNSRecordSRV) {
+			r.Data.Weight = 20
+		}).
+		Build()
+	if err != nil {
+		t.Fatalf("failed to build SRV record: %s", err)
+	}
+	if srv.ID != "record-id" || srv.ZoneID != "zone-id" || srv.TTL != 3600 || srv.Comment != "testing" ||
+		len(srv.Tags) != 2 || srv.Tags[1] != "env:test" || srv.Data.Weight != 20 {
+		t.Errorf("unexpected values of built record: %+v", srv)
+	}
+
+	// NS, PTR, and TXT records
+	txt := NewRecordBuilder(NewDNSRecordTXT("example.com", "hello")).TTL(60).Record()
+	if txt.TTL != 60 {
+		t.Errorf("expected ttl 60, but got %d", txt.TTL)
+	}
+
+	// proxied and settings
+	cname := NewRecordBuilder(NewDNSRecordCNAME("www", "example.com")).
+		Proxied(true).
+		Settings(DNSRecordSettings{FlattenCNAME: new(bool)}).
+		Record()
+	if !cname.Proxied || cname.Settings == nil || cname.Settings.FlattenCNAME == nil {
+		t.Errorf("unexpected proxied or settings: %+v", cname)
+	}
+
+	// unsupported fields
+	if _, err := NewRecordBuilder(NewDNSRecordMX("example.com", "mail.example.com", 10)).Proxied(true).Build(); err == nil {
+		t.Errorf("should fail with proxied MX record")
+	}
+
+	// invalid values
+	if _, err := NewRecordBuilder(NewDNSRecordA("example.com", "192.168.0.1")).TTL(30).Build(); err == nil {
+		t.Errorf("should fail with invalid ttl")
+	}
+
+	// raw record
+	raw := NewRecordBuilder(DNSRecordRaw{"type": "A", "name": "example.com", "content": "192.168.0.1"}).
+		TTL(120).
+		AddTag("owner:dns-team").
+		Proxied(false).
+		Record()
+	if raw.GetTTL() != 120 || len(raw.GetTags()) != 1 || raw["proxied"] != false {
+		t.Errorf("unexpected values of raw record: %+v", raw)
+	}
+}
+
+func TestDeprecatedSetters(t *testing.T) {
+	a := NewDNSRecordA("www.example.com", "1.2.3.4").
+		SetID("record-id").
+		SetZoneID("zone-id").
+		SetComment("comment").
+		SetTags([]string{"owner:team"}).
+		SetTTL(3600).
+		SetProxied(true)
+
+	if a.ID != "record-id" || a.ZoneID != "zone-id" || a.Comment != "comment" ||
+		!slices.Equal(a.Tags, []string{"owner:team"}) || a.TTL != 3600 || !a.Proxied {
+		t.Errorf("unexpected values set with deprecated setters: %+v", a)
+	}
+
+	if txt := NewDNSRecordTXT("example.com", "text").SetTTL(60); txt.GetTTL() != 60 {
+		t.Errorf("expected ttl 60, but got %d", txt.GetTTL())
+	}
+}
@@ -0,0 +1,805 @@
+package cfgo
+
+// Setters of common values for each type of DNS record, kept for backward compatibility.
+//
+// They will be removed in the next major version: use `RecordBuilder` instead.
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordA) SetID(recordID string) *DNSRecordA {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordA) SetComment(comment string) *DNSRecordA {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordA) SetTags(tags []string) *DNSRecordA {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordA) SetTTL(ttl int) *DNSRecordA {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordA) SetZoneID(zoneID string) *DNSRecordA {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordAAAA) SetID(recordID string) *DNSRecordAAAA {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordAAAA) SetComment(comment string) *DNSRecordAAAA {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordAAAA) SetTags(tags []string) *DNSRecordAAAA {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordAAAA) SetTTL(ttl int) *DNSRecordAAAA {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordAAAA) SetZoneID(zoneID string) *DNSRecordAAAA {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordCAA) SetID(recordID string) *DNSRecordCAA {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordCAA) SetComment(comment string) *DNSRecordCAA {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordCAA) SetTags(tags []string) *DNSRecordCAA {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordCAA) SetTTL(ttl int) *DNSRecordCAA {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordCAA) SetZoneID(zoneID string) *DNSRecordCAA {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordCERT) SetID(recordID string) *DNSRecordCERT {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordCERT) SetComment(comment string) *DNSRecordCERT {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordCERT) SetTags(tags []string) *DNSRecordCERT {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordCERT) SetTTL(ttl int) *DNSRecordCERT {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordCERT) SetZoneID(zoneID string) *DNSRecordCERT {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordCNAME) SetID(recordID string) *DNSRecordCNAME {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordCNAME) SetComment(comment string) *DNSRecordCNAME {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordCNAME) SetTags(tags []string) *DNSRecordCNAME {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordCNAME) SetTTL(ttl int) *DNSRecordCNAME {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordCNAME) SetZoneID(zoneID string) *DNSRecordCNAME {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordDNSKEY) SetID(recordID string) *DNSRecordDNSKEY {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordDNSKEY) SetComment(comment string) *DNSRecordDNSKEY {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordDNSKEY) SetTags(tags []string) *DNSRecordDNSKEY {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordDNSKEY) SetTTL(ttl int) *DNSRecordDNSKEY {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordDNSKEY) SetZoneID(zoneID string) *DNSRecordDNSKEY {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordDS) SetID(recordID string) *DNSRecordDS {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordDS) SetComment(comment string) *DNSRecordDS {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordDS) SetTags(tags []string) *DNSRecordDS {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordDS) SetTTL(ttl int) *DNSRecordDS {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordDS) SetZoneID(zoneID string) *DNSRecordDS {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordHTTPS) SetID(recordID string) *DNSRecordHTTPS {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordHTTPS) SetComment(comment string) *DNSRecordHTTPS {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordHTTPS) SetTags(tags []string) *DNSRecordHTTPS {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordHTTPS) SetTTL(ttl int) *DNSRecordHTTPS {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordHTTPS) SetZoneID(zoneID string) *DNSRecordHTTPS {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordLOC) SetID(recordID string) *DNSRecordLOC {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordLOC) SetComment(comment string) *DNSRecordLOC {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordLOC) SetTags(tags []string) *DNSRecordLOC {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordLOC) SetTTL(ttl int) *DNSRecordLOC {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordLOC) SetZoneID(zoneID string) *DNSRecordLOC {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordMX) SetID(recordID string) *DNSRecordMX {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordMX) SetComment(comment string) *DNSRecordMX {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordMX) SetTags(tags []string) *DNSRecordMX {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordMX) SetTTL(ttl int) *DNSRecordMX {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordMX) SetZoneID(zoneID string) *DNSRecordMX {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordNAPTR) SetID(recordID string) *DNSRecordNAPTR {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordNAPTR) SetComment(comment string) *DNSRecordNAPTR {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordNAPTR) SetTags(tags []string) *DNSRecordNAPTR {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordNAPTR) SetTTL(ttl int) *DNSRecordNAPTR {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordNAPTR) SetZoneID(zoneID string) *DNSRecordNAPTR {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordNS) SetID(recordID string) *DNSRecordNS {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordNS) SetComment(comment string) *DNSRecordNS {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordNS) SetTags(tags []string) *DNSRecordNS {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordNS) SetTTL(ttl int) *DNSRecordNS {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordNS) SetZoneID(zoneID string) *DNSRecordNS {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordPTR) SetID(recordID string) *DNSRecordPTR {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordPTR) SetComment(comment string) *DNSRecordPTR {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordPTR) SetTags(tags []string) *DNSRecordPTR {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordPTR) SetTTL(ttl int) *DNSRecordPTR {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordPTR) SetZoneID(zoneID string) *DNSRecordPTR {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordSMIMEA) SetID(recordID string) *DNSRecordSMIMEA {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordSMIMEA) SetComment(comment string) *DNSRecordSMIMEA {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordSMIMEA) SetTags(tags []string) *DNSRecordSMIMEA {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordSMIMEA) SetTTL(ttl int) *DNSRecordSMIMEA {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordSMIMEA) SetZoneID(zoneID string) *DNSRecordSMIMEA {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordSRV) SetID(recordID string) *DNSRecordSRV {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordSRV) SetComment(comment string) *DNSRecordSRV {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordSRV) SetTags(tags []string) *DNSRecordSRV {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordSRV) SetTTL(ttl int) *DNSRecordSRV {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordSRV) SetZoneID(zoneID string) *DNSRecordSRV {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordSSHFP) SetID(recordID string) *DNSRecordSSHFP {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordSSHFP) SetComment(comment string) *DNSRecordSSHFP {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordSSHFP) SetTags(tags []string) *DNSRecordSSHFP {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordSSHFP) SetTTL(ttl int) *DNSRecordSSHFP {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordSSHFP) SetZoneID(zoneID string) *DNSRecordSSHFP {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordSVCB) SetID(recordID string) *DNSRecordSVCB {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordSVCB) SetComment(comment string) *DNSRecordSVCB {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordSVCB) SetTags(tags []string) *DNSRecordSVCB {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordSVCB) SetTTL(ttl int) *DNSRecordSVCB {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordSVCB) SetZoneID(zoneID string) *DNSRecordSVCB {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordTLSA) SetID(recordID string) *DNSRecordTLSA {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordTLSA) SetComment(comment string) *DNSRecordTLSA {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordTLSA) SetTags(tags []string) *DNSRecordTLSA {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordTLSA) SetTTL(ttl int) *DNSRecordTLSA {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordTLSA) SetZoneID(zoneID string) *DNSRecordTLSA {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordTXT) SetID(recordID string) *DNSRecordTXT {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordTXT) SetComment(comment string) *DNSRecordTXT {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordTXT) SetTags(tags []string) *DNSRecordTXT {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordTXT) SetTTL(ttl int) *DNSRecordTXT {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordTXT) SetZoneID(zoneID string) *DNSRecordTXT {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
+
+// SetID sets the `id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ID(recordID)` instead.
+func (r *DNSRecordURI) SetID(recordID string) *DNSRecordURI {
+	NewRecordBuilder(r).ID(recordID)
+	return r
+}
+
+// SetComment sets the `comment` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Comment(comment)` instead.
+func (r *DNSRecordURI) SetComment(comment string) *DNSRecordURI {
+	NewRecordBuilder(r).Comment(comment)
+	return r
+}
+
+// SetTags sets the `tags` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).Tags(tags...)` instead.
+func (r *DNSRecordURI) SetTags(tags []string) *DNSRecordURI {
+	NewRecordBuilder(r).Tags(tags...)
+	return r
+}
+
+// SetTTL sets the `ttl` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).TTL(ttl)` instead.
+func (r *DNSRecordURI) SetTTL(ttl int) *DNSRecordURI {
+	NewRecordBuilder(r).TTL(ttl)
+	return r
+}
+
+// SetZoneID sets the `zone_id` value of DNS record.
+//
+// Deprecated: use `NewRecordBuilder(record).ZoneID(zoneID)` instead.
+func (r *DNSRecordURI) SetZoneID(zoneID string) *DNSRecordURI {
+	NewRecordBuilder(r).ZoneID(zoneID)
+	return r
+}
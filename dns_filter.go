@@ -0,0 +1,188 @@
+package cfgo
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// StringOperator for matching string values in filters
+type StringOperator string
+
+const (
+	FilterExact      StringOperator = "exact"
+	FilterContains   StringOperator = "contains"
+	FilterStartsWith StringOperator = "startswith"
+	FilterEndsWith   StringOperator = "endswith"
+)
+
+// MatchMode for combining multiple filters
+type MatchMode string
+
+const (
+	MatchAllConditions MatchMode = "all"
+	MatchAnyConditions MatchMode = "any"
+)
+
+// OrderField for ordering DNS records
+type OrderField string
+
+const (
+	OrderByType    OrderField = "type"
+	OrderByName    OrderField = "name"
+	OrderByContent OrderField = "content"
+	OrderByTTL     OrderField = "ttl"
+	OrderByProxied OrderField = "proxied"
+)
+
+// Direction for ordering
+type Direction string
+
+const (
+	OrderAscending  Direction = "asc"
+	OrderDescending Direction = "desc"
+)
+
+// DNSRecordFilter is a typed builder of queries for listing DNS records.
+//
+// https://developers.cloudflare.com/api/operations/dns-records-for-a-zone-list-dns-records
+type DNSRecordFilter struct {
+	values url.Values
+}
+
+// NewDNSRecordFilter returns a new, empty filter.
+func NewDNSRecordFilter() *DNSRecordFilter {
+	return &DNSRecordFilter{
+		values: url.Values{},
+	}
+}
+
+// Name filters records by their names. (eg. `name.endswith=example.com`)
+func (f *DNSRecordFilter) Name(op StringOperator, value string) *DNSRecordFilter {
+	f.values.Set(fmt.Sprintf("name.%s", op), value)
+	return f
+}
+
+// Content filters records by their contents. (eg. `content.startswith=192.168.`)
+func (f *DNSRecordFilter) Content(op StringOperator, value string) *DNSRecordFilter {
+	f.values.Set(fmt.Sprintf("content.%s", op), value)
+	return f
+}
+
+// Comment filters records by their comments. (eg. `comment.contains=testing`)
+func (f *DNSRecordFilter) Comment(op StringOperator, value string) *DNSRecordFilter {
+	f.values.Set(fmt.Sprintf("comment.%s", op), value)
+	return f
+}
+
+// CommentPresent filters records which have comments.
+func (f *DNSRecordFilter) CommentPresent() *DNSRecordFilter {
+	f.values.Set("comment.present", "")
+	return f
+}
+
+// CommentAbsent filters records which have no comments.
+func (f *DNSRecordFilter) CommentAbsent() *DNSRecordFilter {
+	f.values.Set("comment.absent", "")
+	return f
+}
+
+// Tag filters records by the value of tag with given name. (eg. `tag.exact=owner:dns-team`)
+//
+// Can be called multiple times for multiple tags, combined with `TagMatch`.
+func (f *DNSRecordFilter) Tag(op StringOperator, name, value string) *DNSRecordFilter {
+	f.values.Add(fmt.Sprintf("tag.%s", op), fmt.Sprintf("%s:%s", name, value))
+	return f
+}
+
+// TagPresent filters records which have a tag with given name.
+func (f *DNSRecordFilter) TagPresent(name string) *DNSRecordFilter {
+	f.values.Add("tag.present", name)
+	return f
+}
+
+// TagAbsent filters records which do not have a tag with given name.
+func (f *DNSRecordFilter) TagAbsent(name string) *DNSRecordFilter {
+	f.values.Add("tag.absent", name)
+	return f
+}
+
+// Type filters records by their types.
+//
+// Multiple types are sent as repeated `type` parameters.
+func (f *DNSRecordFilter) Type(types ...DNSRecordType) *DNSRecordFilter {
+	f.values.Del("type")
+	for _, t := range types {
+		f.values.Add("type", string(t))
+	}
+	return f
+}
+
+// Proxied filters records by their proxied status.
+func (f *DNSRecordFilter) Proxied(proxied bool) *DNSRecordFilter {
+	f.values.Set("proxied", fmt.Sprintf("%t", proxied))
+	return f
+}
+
+// Search filters records by given search term, matched against names, contents, comments, and tags.
+func (f *DNSRecordFilter) Search(term string) *DNSRecordFilter {
+	f.values.Set("search", term)
+	return f
+}
+
+// Match sets how the filters are combined. (all: AND, any: OR)
+func (f *DNSRecordFilter) Match(mode MatchMode) *DNSRecordFilter {
+	f.values.Set("match", string(mode))
+	return f
+}
+
+// TagMatch sets how the tag filters are combined. (all: AND, any: OR)
+func (f *DNSRecordFilter) TagMatch(mode MatchMode) *DNSRecordFilter {
+	f.values.Set("tag_match", string(mode))
+	return f
+}
+
+// Order sets the ordering of listed records.
+func (f *DNSRecordFilter) Order(field OrderField, direction Direction) *DNSRecordFilter {
+	f.values.Set("order", string(field))
+	f.values.Set("direction", string(direction))
+	return f
+}
+
+// Values returns the filter as query values.
+func (f *DNSRecordFilter) Values() url.Values {
+	return copyValues(f.values)
+}
+
+// Queries returns the filter as queries for `ListDNSRecords` and similar functions.
+//
+// Parameters with multiple values are returned as `[]string`.
+func (f *DNSRecordFilter) Queries() map[string]any {
+	return valuesToQueries(f.values)
+}
+
+// String returns the encoded query string of the filter.
+func (f *DNSRecordFilter) String() string {
+	return f.values.Encode()
+}
+
+// returns a deep copy of given query values (shared by filters)
+func copyValues(values url.Values) url.Values {
+	copied := url.Values{}
+	for k, vs := range values {
+		copied[k] = append([]string{}, vs...)
+	}
+	return copied
+}
+
+// returns given query values as queries, with multiple values as `[]string` (shared by filters)
+func valuesToQueries(values url.Values) map[string]any {
+	queries := map[string]any{}
+	for k, vs := range values {
+		if len(vs) == 1 {
+			queries[k] = vs[0]
+		} else {
+			queries[k] = append([]string{}, vs...)
+		}
+	}
+	return queries
+}
@@ -0,0 +1,49 @@
+package cfgo
+
+import (
+	"testing"
+)
+
+func TestDNSRecordFilter(t *testing.T) {
+	for _, test := range []struct {
+		filter   *DNSRecordFilter
+		expected string
+	}{
+		{
+			filter:   NewDNSRecordFilter(),
+			expected: "",
+		},
+		{
+			filter:   NewDNSRecordFilter().Name(FilterEndsWith, "example.com").Type(CNAME),
+			expected: "name.endswith=example.com&type=CNAME",
+		},
+		{
+			filter: NewDNSRecordFilter().
+				Content(FilterStartsWith, "192.168.").
+				Comment(FilterContains, "testing").
+				Proxied(false).
+				Type(A, AAAA).
+				Match(MatchAnyConditions),
+			expected: "comment.contains=testing&content.startswith=192.168.&match=any&proxied=false&type=A&type=AAAA",
+		},
+		{
+			filter: NewDNSRecordFilter().
+				TagPresent("env").
+				TagPresent("owner").
+				Tag(FilterExact, "team", "dns & ops").
+				TagMatch(MatchAllConditions).
+				CommentAbsent().
+				Order(OrderByTTL, OrderDescending),
+			expected: "comment.absent=&direction=desc&order=ttl&tag.exact=team%3Adns+%26+ops&tag.present=env&tag.present=owner&tag_match=all",
+		},
+	} {
+		if encoded := test.filter.String(); encoded != test.expected {
+			t.Errorf("expected '%s', but got '%s'", test.expected, encoded)
+		}
+
+		// should be encoded identically through queries
+		if encoded := encodeQueries(test.filter.Queries()).Encode(); encoded != test.expected {
+			t.Errorf("expected '%s' from queries, but got '%s'", test.expected, encoded)
+		}
+	}
+}
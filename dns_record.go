@@ -0,0 +1,332 @@
+package cfgo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DNSRecord interface for DNS records in various types (A, CNAME, MX, ...)
+//
+// Implemented by all DNSRecord* structs (as pointers) and DNSRecordRaw.
+type DNSRecord interface {
+	GetType() DNSRecordType
+	GetName() string
+	GetID() string
+	GetTTL() int
+	GetComment() string
+	GetTags() []string
+	GetContent() string
+
+	// GetData returns the `data` value of types which have one (eg. CAA, SRV), or nil.
+	GetData() any
+
+	// Common returns the common values of DNS record.
+	Common() *DNSRecordCommon
+
+	// Validate checks the values of DNS record before sending.
+	Validate() error
+}
+
+// check if all DNS record types implement DNSRecord interface
+var (
+	_ DNSRecord = (*DNSRecordA)(nil)
+	_ DNSRecord = (*DNSRecordAAAA)(nil)
+	_ DNSRecord = (*DNSRecordCAA)(nil)
+	_ DNSRecord = (*DNSRecordCERT)(nil)
+	_ DNSRecord = (*DNSRecordCNAME)(nil)
+	_ DNSRecord = (*DNSRecordDNSKEY)(nil)
+	_ DNSRecord = (*DNSRecordDS)(nil)
+	_ DNSRecord = (*DNSRecordHTTPS)(nil)
+	_ DNSRecord = (*DNSRecordLOC)(nil)
+	_ DNSRecord = (*DNSRecordMX)(nil)
+	_ DNSRecord = (*DNSRecordNAPTR)(nil)
+	_ DNSRecord = (*DNSRecordNS)(nil)
+	_ DNSRecord = (*DNSRecordPTR)(nil)
+	_ DNSRecord = (*DNSRecordSMIMEA)(nil)
+	_ DNSRecord = (*DNSRecordSRV)(nil)
+	_ DNSRecord = (*DNSRecordSSHFP)(nil)
+	_ DNSRecord = (*DNSRecordSVCB)(nil)
+	_ DNSRecord = (*DNSRecordTLSA)(nil)
+	_ DNSRecord = (*DNSRecordTXT)(nil)
+	_ DNSRecord = (*DNSRecordURI)(nil)
+	_ DNSRecord = DNSRecordRaw(nil)
+)
+
+// constructors of typed records, by their types
+var typedRecords = map[DNSRecordType]func() DNSRecord{
+	A:      func() DNSRecord { return &DNSRecordA{} },
+	AAAA:   func() DNSRecord { return &DNSRecordAAAA{} },
+	CAA:    func() DNSRecord { return &DNSRecordCAA{} },
+	CERT:   func() DNSRecord { return &DNSRecordCERT{} },
+	CNAME:  func() DNSRecord { return &DNSRecordCNAME{} },
+	DNSKEY: func() DNSRecord { return &DNSRecordDNSKEY{} },
+	DS:     func() DNSRecord { return &DNSRecordDS{} },
+	HTTPS:  func() DNSRecord { return &DNSRecordHTTPS{} },
+	LOC:    func() DNSRecord { return &DNSRecordLOC{} },
+	MX:     func() DNSRecord { return &DNSRecordMX{} },
+	NAPTR:  func() DNSRecord { return &DNSRecordNAPTR{} },
+	NS:     func() DNSRecord { return &DNSRecordNS{} },
+	PTR:    func() DNSRecord { return &DNSRecordPTR{} },
+	SMIMEA: func() DNSRecord { return &DNSRecordSMIMEA{} },
+	SRV:    func() DNSRecord { return &DNSRecordSRV{} },
+	SSHFP:  func() DNSRecord { return &DNSRecordSSHFP{} },
+	SVCB:   func() DNSRecord { return &DNSRecordSVCB{} },
+	TLSA:   func() DNSRecord { return &DNSRecordTLSA{} },
+	TXT:    func() DNSRecord { return &DNSRecordTXT{} },
+	URI:    func() DNSRecord { return &DNSRecordURI{} },
+}
+
+// Typed converts the record into a typed one by its type. (eg. `*DNSRecordMX` for MX record)
+//
+// Records of unknown types are returned as (a copy of) DNSRecordRaw, without any loss.
+func (r DNSRecordRaw) Typed() (record DNSRecord, err error) {
+	newRecord, exists := typedRecords[r.GetType()]
+	if !exists {
+		copied := DNSRecordRaw{}
+		for k, v := range r {
+			copied[k] = v
+		}
+		return copied, nil
+	}
+
+	record = newRecord()
+	if err = r.Into(record); err != nil {
+		return nil, fmt.Errorf("failed to convert %s record: %s", r.GetType(), err)
+	}
+
+	return record, nil
+}
+
+// Typed converts the records into typed ones by their types.
+func (rs DNSRecordsRaw) Typed() (records []DNSRecord, err error) {
+	records = make([]DNSRecord, 0, len(rs))
+	for _, r := range rs {
+		var record DNSRecord
+		if record, err = r.Typed(); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// GetType returns the `type` value of DNS record.
+func (r *DNSRecordCommon) GetType() DNSRecordType {
+	return r.Type
+}
+
+// GetName returns the `name` value of DNS record.
+func (r *DNSRecordCommon) GetName() string {
+	return r.Name
+}
+
+// GetID returns the `id` value of DNS record.
+func (r *DNSRecordCommon) GetID() string {
+	return r.ID
+}
+
+// GetTTL returns the `ttl` value of DNS record.
+func (r *DNSRecordCommon) GetTTL() int {
+	return r.TTL
+}
+
+// GetComment returns the `comment` value of DNS record.
+func (r *DNSRecordCommon) GetComment() string {
+	return r.Comment
+}
+
+// GetTags returns the `tags` value of DNS record.
+func (r *DNSRecordCommon) GetTags() []string {
+	return r.Tags
+}
+
+// GetContent returns the `content` value of DNS record.
+func (r *DNSRecordCommon) GetContent() string {
+	return r.Content
+}
+
+// GetData returns nil, as there is no `data` value in common.
+func (r *DNSRecordCommon) GetData() any {
+	return nil
+}
+
+// Common returns the common values of DNS record.
+func (r *DNSRecordCommon) Common() *DNSRecordCommon {
+	return r
+}
+
+// GetData returns the `data` value of DNS record.
+func (r *DNSRecordCAA) GetData() any { return r.Data }
+
+// GetData returns the `data` value of DNS record.
+func (r *DNSRecordCERT) GetData() any { return r.Data }
+
+// GetData returns the `data` value of DNS record.
+func (r *DNSRecordDNSKEY) GetData() any { return r.Data }
+
+// GetData returns the `data` value of DNS record.
+func (r *DNSRecordDS) GetData() any { return r.Data }
+
+// GetData returns the `data` value of DNS record.
+func (r *DNSRecordHTTPS) GetData() any { return r.Data }
+
+// GetData returns the `data` value of DNS record.
+func (r *DNSRecordLOC) GetData() any { return r.Data }
+
+// GetData returns the `data` value of DNS record.
+func (r *DNSRecordNAPTR) GetData() any { return r.Data }
+
+// GetData returns the `data` value of DNS record.
+func (r *DNSRecordSMIMEA) GetData() any { return r.Data }
+
+// GetData returns the `data` value of DNS record.
+func (r *DNSRecordSRV) GetData() any { return r.Data }
+
+// GetData returns the `data` value of DNS record.
+func (r *DNSRecordSSHFP) GetData() any { return r.Data }
+
+// GetData returns the `data` value of DNS record.
+func (r *DNSRecordSVCB) GetData() any { return r.Data }
+
+// GetData returns the `data` value of DNS record.
+func (r *DNSRecordTLSA) GetData() any { return r.Data }
+
+// GetData returns the `data` value of DNS record.
+func (r *DNSRecordURI) GetData() any { return r.Data }
+
+// NS, PTR, and TXT records are defined with DNSRecordCommon, so they do not inherit its methods
+
+// GetType returns the `type` value of DNS record.
+func (r *DNSRecordNS) GetType() DNSRecordType { return r.Common().GetType() }
+
+// GetName returns the `name` value of DNS record.
+func (r *DNSRecordNS) GetName() string { return r.Common().GetName() }
+
+// GetID returns the `id` value of DNS record.
+func (r *DNSRecordNS) GetID() string { return r.Common().GetID() }
+
+// GetTTL returns the `ttl` value of DNS record.
+func (r *DNSRecordNS) GetTTL() int { return r.Common().GetTTL() }
+
+// GetComment returns the `comment` value of DNS record.
+func (r *DNSRecordNS) GetComment() string { return r.Common().GetComment() }
+
+// GetTags returns the `tags` value of DNS record.
+func (r *DNSRecordNS) GetTags() []string { return r.Common().GetTags() }
+
+// GetContent returns the `content` value of DNS record.
+func (r *DNSRecordNS) GetContent() string { return r.Common().GetContent() }
+
+// GetData returns nil, as there is no `data` value in NS record.
+func (r *DNSRecordNS) GetData() any { return nil }
+
+// Common returns the common values of DNS record.
+func (r *DNSRecordNS) Common() *DNSRecordCommon { return (*DNSRecordCommon)(r) }
+
+// GetType returns the `type` value of DNS record.
+func (r *DNSRecordPTR) GetType() DNSRecordType { return r.Common().GetType() }
+
+// GetName returns the `name` value of DNS record.
+func (r *DNSRecordPTR) GetName() string { return r.Common().GetName() }
+
+// GetID returns the `id` value of DNS record.
+func (r *DNSRecordPTR) GetID() string { return r.Common().GetID() }
+
+// GetTTL returns the `ttl` value of DNS record.
+func (r *DNSRecordPTR) GetTTL() int { return r.Common().GetTTL() }
+
+// GetComment returns the `comment` value of DNS record.
+func (r *DNSRecordPTR) GetComment() string { return r.Common().GetComment() }
+
+// GetTags returns the `tags` value of DNS record.
+func (r *DNSRecordPTR) GetTags() []string { return r.Common().GetTags() }
+
+// GetContent returns the `content` value of DNS record.
+func (r *DNSRecordPTR) GetContent() string { return r.Common().GetContent() }
+
+// GetData returns nil, as there is no `data` value in PTR record.
+func (r *DNSRecordPTR) GetData() any { return nil }
+
+// Common returns the common values of DNS record.
+func (r *DNSRecordPTR) Common() *DNSRecordCommon { return (*DNSRecordCommon)(r) }
+
+// GetType returns the `type` value of DNS record.
+func (r *DNSRecordTXT) GetType() DNSRecordType { return r.Common().GetType() }
+
+// GetName returns the `name` value of DNS record.
+func (r *DNSRecordTXT) GetName() string { return r.Common().GetName() }
+
+// GetID returns the `id` value of DNS record.
+func (r *DNSRecordTXT) GetID() string { return r.Common().GetID() }
+
+// GetTTL returns the `ttl` value of DNS record.
+func (r *DNSRecordTXT) GetTTL() int { return r.Common().GetTTL() }
+
+// GetComment returns the `comment` value of DNS record.
+func (r *DNSRecordTXT) GetComment() string { return r.Common().GetComment() }
+
+// GetTags returns the `tags` value of DNS record.
+func (r *DNSRecordTXT) GetTags() []string { return r.Common().GetTags() }
+
+// GetContent returns the `content` value of DNS record.
+func (r *DNSRecordTXT) GetContent() string { return r.Common().GetContent() }
+
+// GetData returns nil, as there is no `data` value in TXT record.
+func (r *DNSRecordTXT) GetData() any { return nil }
+
+// Common returns the common values of DNS record.
+func (r *DNSRecordTXT) Common() *DNSRecordCommon { return (*DNSRecordCommon)(r) }
+
+// GetName returns the `name` value of DNS record.
+func (r DNSRecordRaw) GetName() string {
+	name, _ := r.StringFor("name")
+	return name
+}
+
+// GetID returns the `id` value of DNS record.
+func (r DNSRecordRaw) GetID() string {
+	id, _ := r.StringFor("id")
+	return id
+}
+
+// GetTTL returns the `ttl` value of DNS record.
+func (r DNSRecordRaw) GetTTL() int {
+	ttl, _ := r.IntFor("ttl")
+	return ttl
+}
+
+// GetComment returns the `comment` value of DNS record.
+func (r DNSRecordRaw) GetComment() string {
+	comment, _ := r.StringFor("comment")
+	return comment
+}
+
+// GetTags returns the `tags` value of DNS record.
+func (r DNSRecordRaw) GetTags() []string {
+	tags, _ := r.StringsFor("tags")
+	return tags
+}
+
+// GetContent returns the `content` value of DNS record.
+func (r DNSRecordRaw) GetContent() string {
+	content, _ := r.StringFor("content")
+	return content
+}
+
+// GetData returns the `data` value of DNS record, or nil if there is none.
+func (r DNSRecordRaw) GetData() any {
+	return r["data"]
+}
+
+// Common returns a copy of the common values of DNS record.
+//
+// Changes on the returned value are not applied to this record.
+func (r DNSRecordRaw) Common() *DNSRecordCommon {
+	common := DNSRecordCommon{}
+	if encoded, err := json.Marshal(r); err == nil {
+		_ = json.Unmarshal(encoded, &common)
+	}
+
+	return &common
+}
@@ -0,0 +1,82 @@
+package cfgo
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDNSRecordInterface(t *testing.T) {
+	caa := NewRecordBuilder(NewDNSRecordCAA("example.com", 0, "issue", "letsencrypt.org")).
+		TTL(300).
+		Comment("testing").
+		Record()
+
+	var raw DNSRecordRaw
+	if err := json.Unmarshal([]byte(`{"id":"raw-id","type":"TXT","name":"raw.example.com","content":"hello","ttl":120,"tags":["owner:dns"]}`), &raw); err != nil {
+		t.Fatalf("failed to unmarshal raw record: %s", err)
+	}
+
+	records := []DNSRecord{
+		NewDNSRecordA("a.example.com", "192.168.0.1"),
+		NewDNSRecordAAAA("aaaa.example.com", "::1"),
+		NewDNSRecordNS("example.com", "ns1.example.com"),
+		NewDNSRecordTXT("txt.example.com", "hello"),
+		caa,
+		raw,
+	}
+	for _, record := range records {
+		if record.GetType() == Undefined || record.GetName() == "" {
+			t.Errorf("type or name is missing in record: %+v", record)
+		}
+		if record.Common().Type != record.GetType() {
+			t.Errorf("common values mismatch: %+v", record)
+		}
+	}
+
+	// typed data
+	if caa.GetTTL() != 300 || caa.GetComment() != "testing" || caa.GetData() == nil {
+		t.Errorf("unexpected values of CAA record: %+v", caa)
+	}
+
+	// values of raw record decoded from JSON
+	if raw.GetID() != "raw-id" || raw.GetTTL() != 120 || len(raw.GetTags()) != 1 || raw.GetContent() != "hello" {
+		t.Errorf("unexpected values of raw record: %+v", raw)
+	}
+
+	// changes through `Common` are applied to typed records
+	ns := NewDNSRecordNS("example.com", "ns1.example.com")
+	ns.Common().TTL = 3600
+	if ns.GetTTL() != 3600 {
+		t.Errorf("expected ttl 3600, but got %d", ns.GetTTL())
+	}
+}
+
+func TestDNSRecordRawTyped(t *testing.T) {
+	var raws DNSRecordsRaw
+	if err := json.Unmarshal([]byte(`[
+		{"type":"MX","name":"example.com","content":"mail.example.com","priority":10},
+		{"type":"SRV","name":"_sip._tcp.example.com","data":{"priority":1,"weight":2,"port":5060,"target":"sip.example.com"}},
+		{"type":"OPENPGPKEY","name":"example.com","content":"key","unknown_field":{"nested":true}}
+	]`), &raws); err != nil {
+		t.Fatalf("failed to unmarshal raw records: %s", err)
+	}
+
+	records, err := raws.Typed()
+	if err != nil {
+		t.Fatalf("failed to convert raw records: %s", err)
+	}
+
+	if mx, ok := records[0].(*DNSRecordMX); !ok || mx.Content != "mail.example.com" {
+		t.Errorf("expected a MX record, but got %+v", records[0])
+	}
+	if srv, ok := records[1].(*DNSRecordSRV); !ok || srv.Data.Port != 5060 {
+		t.Errorf("expected a SRV record, but got %+v", records[1])
+	}
+
+	// unknown types are preserved
+	if raw, ok := records[2].(DNSRecordRaw); !ok {
+		t.Errorf("expected a raw record, but got %T", records[2])
+	} else if encoded, _ := json.Marshal(raw); string(encoded) != `{"content":"key","name":"example.com","type":"OPENPGPKEY","unknown_field":{"nested":true}}` {
+		t.Errorf("unknown record was not preserved: %s", encoded)
+	}
+}
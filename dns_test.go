@@ -1,9 +1,16 @@
 package cfgo
 
 import (
+	"encoding/json"
+	"io"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/meinside/cloudflare-go/cfgotest"
 )
 
 func TestCRUDRequests(t *testing.T) {
@@ -16,99 +23,415 @@ func TestCRUDRequests(t *testing.T) {
 		verbose = true
 	}
 
+	var client *CloudflareClient
 	if email != "" && apiKey != "" && zoneID != "" {
-		client := NewCloudflareClient(email, apiKey)
-		client.Verbose = verbose
+		client = NewCloudflareClient(email, apiKey)
+	} else {
+		// run against the fake server, without `EMAIL`, `API_KEY`, and `ZONE_ID`
+		server := cfgotest.NewServer()
+		defer server.Close()
 
-		// list zones
-		if zones, err := client.ListZones(); err == nil {
-			if len(zones.Result) <= 0 {
-				t.Errorf("no zones found")
-			}
-		} else {
-			t.Errorf("failed to list zones: %s", err)
+		zoneID = server.AddZone("example.com")
+		client = NewCloudflareClientWithAPIToken("test-token", WithBaseURL(server.BaseURL()))
+	}
+	client.Verbose = verbose
+
+	// list zones
+	if zones, err := client.ListZones(); err == nil {
+		if len(zones.Result) <= 0 {
+			t.Errorf("no zones found")
 		}
+	} else {
+		t.Errorf("failed to list zones: %s", err)
+	}
 
-		// create a record
-		cname := NewDNSRecordCNAME("testing", "test.somewhere.com")
-		cname.SetComment("CNAME record for testing the library.")
-		cname.SetTTL(3600)
+	// create a record
+	cname := NewRecordBuilder(NewDNSRecordCNAME("testing", "test.somewhere.com")).
+		Comment("CNAME record for testing the library.").
+		TTL(3600).
+		Record()
 
-		if created, err := client.CreateDNSRecord(zoneID, cname); err == nil {
-			var createdCNAME DNSRecordCNAME
-			if err := created.Result.Into(&createdCNAME); err != nil {
-				t.Errorf("failed to parse created result: %s", err)
-			} else {
+	if created, err := client.CreateDNSRecord(zoneID, cname); err == nil {
+		if typed, err := created.TypedResult(); err != nil {
+			t.Errorf("failed to parse created result: %s", err)
+		} else if createdCNAME, ok := typed.(*DNSRecordCNAME); !ok {
+			t.Errorf("expected a CNAME record, but got %T", typed)
+		} else {
+			if verbose {
+				log.Printf("created dns record = %+v", created)
+			}
+
+			// list records
+			if retrieved, err := client.ListDNSRecords(zoneID, NewDNSRecordFilter().
+				Comment(FilterContains, "testing").
+				Type(createdCNAME.Type).
+				Queries()); err == nil {
 				if verbose {
-					log.Printf("created dns record = %+v", created)
+					log.Printf("retrieved dns records = %+v", retrieved)
 				}
 
-				// list records
-				if retrieved, err := client.ListDNSRecords(zoneID, map[string]any{
-					"comment.contains": "testing",
-					"type":             createdCNAME.Type,
-				}); err == nil {
-					if verbose {
-						log.Printf("retrieved dns records = %+v", retrieved)
-					}
+				exists := false
+				if records, err := retrieved.TypedResult(); err == nil {
+					for _, record := range records {
+						// check if the created record exists
+						if record, ok := record.(*DNSRecordCNAME); ok && createdCNAME.ID == record.ID {
+							exists = true
 
-					exists := false
-					var record DNSRecordCNAME
-					for _, rawRecord := range retrieved.Result {
-						if rawRecord.GetType() == createdCNAME.Type {
-							if err := rawRecord.Into(&record); err == nil {
-								// check if the created record exists
-								if createdCNAME.ID == record.ID {
-									exists = true
-
-									if verbose {
-										log.Printf("matched dns record = %+v", record)
-									}
-
-									break
-								}
-							} else {
-								t.Errorf("failed to parse raw record: %s", err)
+							if verbose {
+								log.Printf("matched dns record = %+v", record)
 							}
-						}
-					}
 
-					if !exists {
-						t.Errorf("there was no newly-created dns record in the retrieved dns records")
+							break
+						}
 					}
 				} else {
-					t.Errorf("failed to list records: %s", err)
+					t.Errorf("failed to parse raw records: %s", err)
 				}
 
-				// update a record
-				cname.SetComment("Updated CNAME record for testing the library.")
-				if updated, err := client.UpdateDNSRecord(zoneID, createdCNAME.ID, cname); err == nil {
-					var updatedCNAME DNSRecordCNAME
-					if err := updated.Result.Into(&updatedCNAME); err != nil {
-						t.Errorf("failed to parse updated result: %s", err)
-					} else {
-						if verbose {
-							log.Printf("updated dns record = %+v", updatedCNAME)
-						}
-					}
-				} else {
-					t.Errorf("failed to update dns record: %s", err)
+				if !exists {
+					t.Errorf("there was no newly-created dns record in the retrieved dns records")
 				}
+			} else {
+				t.Errorf("failed to list records: %s", err)
+			}
 
-				// delete the record
-				if deleted, err := client.DeleteDNSRecord(zoneID, createdCNAME.ID); err != nil {
-					t.Errorf("failed to delete dns record: %s", err)
+			// update a record
+			cname.Comment = "Updated CNAME record for testing the library."
+			if updated, err := client.UpdateDNSRecord(zoneID, createdCNAME.ID, cname); err == nil {
+				if updatedCNAME, err := updated.TypedResult(); err != nil {
+					t.Errorf("failed to parse updated result: %s", err)
 				} else {
 					if verbose {
-						log.Printf("deleted dns record = %+v", deleted)
+						log.Printf("updated dns record = %+v", updatedCNAME)
 					}
 				}
+			} else {
+				t.Errorf("failed to update dns record: %s", err)
+			}
+
+			// delete the record
+			if deleted, err := client.DeleteDNSRecord(zoneID, createdCNAME.ID); err != nil {
+				t.Errorf("failed to delete dns record: %s", err)
+			} else {
+				if verbose {
+					log.Printf("deleted dns record = %+v", deleted)
+				}
+			}
+		}
+	} else {
+		t.Errorf("failed to create dns record: %s", err)
+	}
+}
+
+func TestDNSRecordPatch(t *testing.T) {
+	patch := NewDNSRecordPatch().
+		Set("comment", "patched").
+		Set("data.priority", 10).
+		Set("data", map[string]any{"weight": 5}).
+		Set("settings.flatten_cname", false)
+
+	if encoded, err := json.Marshal(patch); err != nil {
+		t.Errorf("failed to encode patch: %s", err)
+	} else if expected := `{"comment":"patched","data":{"priority":10,"weight":5},"settings":{"flatten_cname":false}}`; string(encoded) != expected {
+		t.Errorf("expected '%s', but got '%s'", expected, string(encoded))
+	}
+
+	// typed values are merged regardless of the order of keys
+	for range 100 {
+		patch := NewDNSRecordPatch().
+			Set("data", struct {
+				Weight int `json:"weight"`
+			}{5}).
+			Set("data.priority", 10).
+			Set("settings", map[string]bool{"ipv4_only": true})
+		if encoded, err := json.Marshal(patch); err != nil {
+			t.Fatalf("failed to encode patch: %s", err)
+		} else if expected := `{"data":{"priority":10,"weight":5},"settings":{"ipv4_only":true}}`; string(encoded) != expected {
+			t.Fatalf("expected '%s', but got '%s'", expected, string(encoded))
+		}
+	}
+
+	// conflicting values
+	for _, patch := range []DNSRecordPatch{
+		NewDNSRecordPatch().Set("data", map[string]int{"priority": 5}).Set("data.priority", 10),
+		NewDNSRecordPatch().Set("comment", "not an object").Set("comment.text", "patched"),
+	} {
+		if _, err := json.Marshal(patch); err == nil {
+			t.Errorf("expected an error for conflicting values of %v", patch)
+		}
+	}
+}
+
+func TestDNSRecordRawAccessors(t *testing.T) {
+	var raw DNSRecordRaw
+	if err := json.Unmarshal([]byte(`{
+		"type": "SRV",
+		"name": "_sip._tcp.example.com",
+		"ttl": 3600,
+		"proxied": false,
+		"tags": ["owner:dns", "env:test"],
+		"created_on": "2024-01-02T03:04:05.123456Z",
+		"data": {"priority": 10, "weight": 5.5},
+		"settings": {"flatten_cname": true}
+	}`), &raw); err != nil {
+		t.Fatalf("failed to unmarshal raw record: %s", err)
+	}
+
+	if ttl, err := raw.IntFor("ttl"); err != nil || ttl != 3600 {
+		t.Errorf("expected ttl 3600, but got %d (%v)", ttl, err)
+	}
+	if tags, err := raw.StringsFor("tags"); err != nil || len(tags) != 2 || tags[1] != "env:test" {
+		t.Errorf("unexpected tags: %v (%v)", tags, err)
+	}
+	if proxied, err := raw.BoolFor("proxied"); err != nil || proxied {
+		t.Errorf("expected proxied false, but got %t (%v)", proxied, err)
+	}
+	if createdOn, err := raw.TimeFor("created_on"); err != nil || createdOn.Year() != 2024 || createdOn.Nanosecond() != 123456000 {
+		t.Errorf("unexpected created_on: %s (%v)", createdOn, err)
+	}
+
+	// dotted paths
+	if priority, err := raw.IntFor("data.priority"); err != nil || priority != 10 {
+		t.Errorf("expected data.priority 10, but got %d (%v)", priority, err)
+	}
+	if weight, err := raw.FloatFor("data.weight"); err != nil || weight != 5.5 {
+		t.Errorf("expected data.weight 5.5, but got %f (%v)", weight, err)
+	}
+	if flatten, err := raw.BoolFor("settings.flatten_cname"); err != nil || !flatten {
+		t.Errorf("expected settings.flatten_cname true, but got %t (%v)", flatten, err)
+	}
+
+	// errors
+	if _, err := raw.IntFor("data.weight"); err == nil {
+		t.Errorf("should fail with a fractional number")
+	}
+	if _, err := raw.StringFor("data.priority.nested"); err == nil {
+		t.Errorf("should fail with a non-existent path")
+	}
+	if _, err := raw.TimeFor("name"); err == nil {
+		t.Errorf("should fail with a non-time value")
+	}
+}
+
+func TestDNSRecordProxiedAndSettings(t *testing.T) {
+	// explicit `false` values are kept
+	cname := NewDNSRecordCNAME("www", "example.com").
+		SetProxied(false).
+		SetFlattenCNAME(false).
+		SetIPv6Only(true)
+
+	encoded, err := json.Marshal(cname)
+	if err != nil {
+		t.Fatalf("failed to encode record: %s", err)
+	}
+	if expected := `{"content":"example.com","name":"www","type":"CNAME","meta":{},"proxied":false,"settings":{"ipv6_only":true,"flatten_cname":false}}`; string(encoded) != expected {
+		t.Errorf("expected '%s', but got '%s'", expected, string(encoded))
+	}
+
+	// unset settings are omitted, but `proxied` is always sent
+	if encoded, _ := json.Marshal(NewDNSRecordA("example.com", "192.168.0.1")); !strings.Contains(string(encoded), `"proxied":false`) || strings.Contains(string(encoded), "settings") {
+		t.Errorf("unset settings should be omitted, but `proxied` should be sent: %s", encoded)
+	}
+
+	// compatible with `Proxied` values in struct literals
+	if record := (DNSRecordA{DNSRecordCommon: DNSRecordCommon{Type: A, Name: "example.com", Content: "192.168.0.1"}, Proxied: true}); !record.Proxied {
+		t.Errorf("expected a proxied record")
+	}
+
+	// round trip
+	var decoded DNSRecordCNAME
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatalf("failed to decode record: %s", err)
+	}
+	if decoded.Proxied {
+		t.Errorf("expected proxied false, but got %v", decoded.Proxied)
+	}
+	if decoded.Settings == nil || decoded.Settings.FlattenCNAME == nil || *decoded.Settings.FlattenCNAME || decoded.Settings.IPv4Only != nil {
+		t.Errorf("unexpected settings: %+v", decoded.Settings)
+	}
+
+	// validation of settings
+	if err := NewDNSRecordAAAA("example.com", "::1").SetIPv4Only(true).SetIPv6Only(true).Validate(); err == nil {
+		t.Errorf("should fail with both `ipv4_only` and `ipv6_only` enabled")
+	}
+}
+
+func TestUpsertDNSRecord(t *testing.T) {
+	server := cfgotest.NewServer()
+	defer server.Close()
+
+	zoneID := server.AddZone("example.com")
+	client := NewCloudflareClientWithAPIToken("test-token", WithBaseURL(server.BaseURL()))
+
+	// create, then update the only record
+	var recordID string
+	if upserted, err := client.UpsertDNSRecord(zoneID, NewDNSRecordA("ddns.example.com", "1.2.3.4")); err != nil || !upserted.Created {
+		t.Fatalf("expected a created record, but got %+v (%v)", upserted, err)
+	} else {
+		recordID = upserted.Result.GetID()
+	}
+	if upserted, err := client.UpsertDNSRecord(zoneID, NewDNSRecordA("DDNS.example.com.", "5.6.7.8")); err != nil || upserted.Created || upserted.Result.GetID() != recordID || upserted.Result.GetContent() != "5.6.7.8" {
+		t.Errorf("expected an updated record '%s', but got %+v (%v)", recordID, upserted, err)
+	}
+	if records, err := client.FindDNSRecords(zoneID, "ddns.example.com", A); err != nil || len(records) != 1 {
+		t.Errorf("expected 1 record, but got %d (%v)", len(records), err)
+	}
+
+	// relative names and `@` are qualified with the zone name
+	for _, name := range []string{"relative", "@"} {
+		for _, content := range []string{"1.2.3.4", "5.6.7.8"} {
+			if _, err := client.UpsertDNSRecord(zoneID, NewDNSRecordA(name, content)); err != nil {
+				t.Errorf("failed to upsert record '%s': %s", name, err)
 			}
+		}
+		if records, err := client.FindDNSRecords(zoneID, name, A); err != nil || len(records) != 1 || records[0].GetContent() != "5.6.7.8" {
+			t.Errorf("expected 1 updated record named '%s', but got %+v (%v)", name, records, err)
+		}
+	}
+	if records, err := client.FindDNSRecords(zoneID, "example.com", A); err != nil || len(records) != 1 {
+		t.Errorf("expected 1 record at the zone apex, but got %d (%v)", len(records), err)
+	}
+
+	// multi-value records are chosen by content
+	server.AddDNSRecord(zoneID, map[string]any{"type": "A", "name": "rr.example.com", "content": "10.0.0.1", "ttl": 1})
+	server.AddDNSRecord(zoneID, map[string]any{"type": "A", "name": "rr.example.com", "content": "10.0.0.2", "ttl": 1})
+	if upserted, err := client.UpsertDNSRecord(zoneID, NewRecordBuilder(NewDNSRecordA("rr.example.com", "10.0.0.2")).TTL(300).Record()); err != nil || upserted.Created || upserted.Result.GetTTL() != 300 {
+		t.Errorf("expected an updated record, but got %+v (%v)", upserted, err)
+	}
+	if upserted, err := client.UpsertDNSRecord(zoneID, NewDNSRecordA("rr.example.com", "10.0.0.3")); err != nil || !upserted.Created || upserted.Result.GetContent() != "10.0.0.3" {
+		t.Errorf("expected a new member of the record set, but got %+v (%v)", upserted, err)
+	}
+	if records, err := client.FindDNSRecords(zoneID, "rr.example.com", A); err != nil || len(records) != 3 {
+		t.Errorf("expected 3 records, but got %d (%v)", len(records), err)
+	}
+	if _, err := client.UpsertDNSRecord(zoneID, DNSRecordRaw{"type": "A", "name": "rr.example.com"}); !IsAmbiguous(err) {
+		t.Errorf("expected an ambiguity error, but got %v", err)
+	}
+
+	// contents are case-sensitive, except for hostnames
+	server.AddDNSRecord(zoneID, map[string]any{"type": "TXT", "name": "txt.example.com", "content": "ABC"})
+	server.AddDNSRecord(zoneID, map[string]any{"type": "TXT", "name": "txt.example.com", "content": "DEF"})
+	if upserted, err := client.UpsertDNSRecord(zoneID, NewDNSRecordTXT("txt", "abc")); err != nil || !upserted.Created {
+		t.Errorf("expected a new TXT record, but got %+v (%v)", upserted, err)
+	}
+	if record, err := client.FindDNSRecord(zoneID, "txt", TXT, "ABC"); err != nil || record.GetContent() != "ABC" {
+		t.Errorf("expected the TXT record with exactly the same content, but got %+v (%v)", record, err)
+	}
+	server.AddDNSRecord(zoneID, map[string]any{"type": "CNAME", "name": "alias.example.com", "content": "target.example.com"})
+	if record, err := client.FindDNSRecord(zoneID, "alias", CNAME, "TARGET.example.com."); err != nil || record.GetContent() != "target.example.com" {
+		t.Errorf("expected the CNAME record with the same hostname, but got %+v (%v)", record, err)
+	}
+
+	// find only one
+	if record, err := client.FindDNSRecord(zoneID, "rr.example.com", A, "10.0.0.1"); err != nil || record.GetContent() != "10.0.0.1" {
+		t.Errorf("expected a record with content, but got %+v (%v)", record, err)
+	}
+	if _, err := client.FindDNSRecord(zoneID, "rr.example.com", A, ""); !IsAmbiguous(err) {
+		t.Errorf("expected an ambiguity error, but got %v", err)
+	}
+	if _, err := client.FindDNSRecord(zoneID, "rr.example.com", A, "10.0.0.9"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, but got %v", err)
+	}
+	if _, err := client.FindDNSRecord(zoneID, "none.example.com", AAAA, ""); !IsNotFound(err) {
+		t.Errorf("expected a not found error, but got %v", err)
+	}
+}
+
+func TestExportDNSRecords(t *testing.T) {
+	server := cfgotest.NewServer()
+	defer server.Close()
+
+	zoneID := server.AddZone("example.com")
+	server.AddDNSRecord(zoneID, map[string]any{"type": "A", "name": "www.example.com", "content": "1.2.3.4", "ttl": 3600})
+	server.AddDNSRecord(zoneID, map[string]any{"type": "MX", "name": "example.com", "content": "mx.example.com", "priority": 10})
+	client := NewCloudflareClientWithAPIToken("test-token", WithBaseURL(server.BaseURL()))
+	client.RetryPolicy = &RetryPolicy{
+		MaxAttempts: 2,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+	}
+
+	// non-JSON response (retried on failures)
+	server.RateLimitNext(1)
+	if exported, err := client.ExportDNSRecords(zoneID); err == nil {
+		defer exported.Close()
+
+		if bytes, err := io.ReadAll(exported); err != nil {
+			t.Errorf("failed to read exported records: %s", err)
 		} else {
-			t.Errorf("failed to create dns record: %s", err)
+			for _, line := range []string{
+				"www.example.com.\t3600\tIN\tA\t1.2.3.4",
+				"example.com.\t1\tIN\tMX\t10 mx.example.com.",
+			} {
+				if !strings.Contains(string(bytes), line) {
+					t.Errorf("expected line '%s' in exported records, but got:\n%s", line, bytes)
+				}
+			}
 		}
 	} else {
-		t.Errorf("Export `EMAIL`, API_KEY`, and `ZONE_ID` before running tests.")
+		t.Errorf("failed to export records: %s", err)
 	}
 
+	// JSON error response
+	if _, err := client.ExportDNSRecords("0123456789abcdef0123456789abcdef"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, but got %v", err)
+	}
+}
+
+func TestImportDNSRecords(t *testing.T) {
+	server := cfgotest.NewServer()
+	defer server.Close()
+
+	zoneID := server.AddZone("example.com")
+	cassette := filepath.Join(t.TempDir(), "cassette.json")
+	client := NewCloudflareClientWithAPIToken("test-token", WithBaseURL(server.BaseURL()), WithTransport(NewRecorder(cassette, nil)))
+
+	const zoneFile = `$ORIGIN example.com.
+$TTL 3600
+@	IN	SOA	ns1.example.com. admin.example.com. (
+		2026101701 ; serial
+		7200 3600 1209600 3600 )
+@		IN	MX	10 mx.example.com.
+www	300	IN	A	1.2.3.4
+		IN	AAAA	::1
+blog	IN	CNAME	www ; relative name
+txt.example.com.	IN	TXT	"v=spf1 -all; not a comment"
+_sip._tcp	IN	SRV	10 5 5060 sip.example.com.
+`
+
+	// multipart request
+	imported, err := client.ImportDNSRecords(zoneID, strings.NewReader(zoneFile), &DNSRecordsImportOptions{Proxied: true})
+	if err != nil {
+		t.Fatalf("failed to import records: %s", err)
+	}
+	if imported.Result.RecsAdded != 5 || imported.Result.TotalRecordsParsed != 6 {
+		t.Errorf("expected 5 of 6 records added, but got %+v", imported.Result)
+	}
+	if record, err := client.FindDNSRecord(zoneID, "blog.example.com", CNAME, ""); err != nil || record.GetContent() != "www.example.com" {
+		t.Errorf("expected an imported CNAME record, but got %+v (%v)", record, err)
+	} else if proxied, _ := record.BoolFor("proxied"); !proxied {
+		t.Errorf("expected a proxied record, but got %+v", record)
+	}
+	if record, err := client.FindDNSRecord(zoneID, "txt.example.com", TXT, ""); err != nil || record.GetContent() != "v=spf1 -all; not a comment" {
+		t.Errorf("expected an imported TXT record, but got %+v (%v)", record, err)
+	}
+
+	// same multipart bodies can be replayed
+	replayer, err := NewReplayer(cassette)
+	if err != nil {
+		t.Fatalf("failed to load cassette: %s", err)
+	}
+	replaying := NewCloudflareClientWithAPIToken("test-token", WithBaseURL(server.BaseURL()), WithTransport(replayer))
+	if replayed, err := replaying.ImportDNSRecords(zoneID, strings.NewReader(zoneFile), &DNSRecordsImportOptions{Proxied: true}); err != nil || replayed.Result != imported.Result {
+		t.Errorf("expected a replayed result %+v, but got %+v (%v)", imported.Result, replayed.Result, err)
+	}
+
+	// different file contents are not matched
+	if replayer, err = NewReplayer(cassette); err != nil {
+		t.Fatalf("failed to load cassette: %s", err)
+	}
+	replaying = NewCloudflareClientWithAPIToken("test-token", WithBaseURL(server.BaseURL()), WithTransport(replayer))
+	if _, err := replaying.ImportDNSRecords(zoneID, strings.NewReader(zoneFile+"new\tIN\tA\t5.6.7.8\n"), &DNSRecordsImportOptions{Proxied: true}); err == nil {
+		t.Errorf("expected no recorded interaction for a different zone file")
+	}
 }
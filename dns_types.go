@@ -3,80 +3,35 @@ package cfgo
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
 )
 
+// ResponseMessage struct for errors and messages in responses
+type ResponseMessage struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
 // ResponseCommon struct for common response
 type ResponseCommon struct {
-	Errors []struct {
-		Code    int    `json:"code"`
-		Message string `json:"message"`
-	} `json:"errors"`
-	Messages []struct {
-		Code    int    `json:"code"`
-		Message string `json:"message"`
-	} `json:"messages"`
+	Errors   []ResponseMessage `json:"errors"`
+	Messages []ResponseMessage `json:"messages"`
 
 	Success bool `json:"success"`
 }
 
-// Zone struct for all the zones
-type Zone struct {
-	Name    string `json:"name"`
-	Account struct {
-		ID   string `json:"id"`
-		Name string `json:"name"`
-	} `json:"account"`
-	CreatedOn       string `json:"created_on"`
-	ActivatedOn     string `json:"activated_on"`
-	ModifiedOn      string `json:"modified_on"`
-	DevelopmentMode int    `json:"development_mode"`
-	ID              string `json:"id"`
-	Meta            struct {
-		CustomCertificateQuoti  int  `json:"custom_certificate_quota"`
-		MultipleRailgunsAllowed bool `json:"multiple_railguns_allowed"`
-		PageRuleQuota           int  `json:"page_rule_quota"`
-		PhishingDetected        bool `json:"phishing_detected"`
-		Step                    int  `json:"step"`
-	} `json:"meta"`
-	NameServers         []string `json:"name_servers"`
-	OriginalDNSHost     string   `json:"original_dnshost"`
-	OriginalNameServers []string `json:"original_name_servers"`
-	OriginalRegistrar   string   `json:"original_registrar"`
-	Owner               struct {
-		Email string `json:"email"`
-		ID    string `json:"id"`
-		Type  string `json:"type"`
-	} `json:"owner"`
-	Paused      bool     `json:"paused"`
-	Permissions []string `json:"permissions"`
-	Plan        struct {
-		CanSubscribe      bool   `json:"can_subscribe"`
-		Currency          string `json:"currency"`
-		ExternallyManaged bool   `json:"externally_managed"`
-		Frequency         string `json:"frequency"`
-		ID                string `json:"id"`
-		IsSubscribed      bool   `json:"is_subscribed"`
-		LegacyDiscount    bool   `json:"legacy_discount"`
-		LegacyID          string `json:"legacy_id"`
-		Name              string `json:"name"`
-		Price             int    `json:"price"`
-	} `json:"plan"`
-	Status string `json:"status"`
-	Tenant struct {
-		ID   string `json:"id"`
-		Name string `json:"name"`
-	} `json:"tenant"`
-	TenantUnit struct {
-		ID string `json:"id"`
-	} `json:"tenant_unit"`
-	Type string `json:"type"`
-}
-
-// ResponseZones for zones response
-type ResponseZones struct {
-	ResponseCommon
-
-	Result []Zone `json:"result"`
+// ResultInfo struct for the pagination info of list responses
+type ResultInfo struct {
+	Count      int `json:"count,omitempty"`
+	Page       int `json:"page,omitempty"`
+	PerPage    int `json:"per_page,omitempty"`
+	TotalCount int `json:"total_count,omitempty"`
+	TotalPages int `json:"total_pages,omitempty"`
 }
 
 // DNSRecordType for the type of DNSRecords
@@ -113,35 +68,148 @@ type DNSRecordRaw map[string]any
 // DNSRecordsRaw type for arrays of DNSRecordRaw structs
 type DNSRecordsRaw []DNSRecordRaw
 
+// returns the value for given key, which can be a dotted path to a nested value (eg. `data.priority`)
+func (r DNSRecordRaw) lookup(key string) (value any, err error) {
+	// exact key first
+	if v, exists := r[key]; exists {
+		return v, nil
+	}
+
+	value = map[string]any(r)
+	for _, k := range strings.Split(key, ".") {
+		var nested map[string]any
+		switch v := value.(type) {
+		case map[string]any:
+			nested = v
+		case DNSRecordRaw:
+			nested = v
+		default:
+			return nil, fmt.Errorf("no such value for key: '%s'", key)
+		}
+
+		var exists bool
+		if value, exists = nested[k]; !exists {
+			return nil, fmt.Errorf("no such value for key: '%s'", key)
+		}
+	}
+
+	return value, nil
+}
+
 // generic function for returning a value for given key from the records
 func valueFor[T any](r DNSRecordRaw, key string) (value T, err error) {
-	var exists bool
 	var v any
-	if v, exists = r[key]; exists {
+	if v, err = r.lookup(key); err == nil {
 		var ok bool
 		if value, ok = v.(T); ok {
 			return value, nil
 		} else {
 			return value, fmt.Errorf("value for key: '%s' could not be converted to %T", key, value)
 		}
-	} else {
-		return value, fmt.Errorf("no such value for key: '%s'", key)
 	}
+
+	return value, err
 }
 
 // StringFor returns the string value with given key string.
+//
+// Key can be a dotted path to a nested value. (eg. `data.target`)
 func (r DNSRecordRaw) StringFor(key string) (value string, err error) {
 	return valueFor[string](r, key)
 }
 
 // StringsFor returns the string array with given key string.
+//
+// Arrays decoded from JSON (`[]any`) are converted when all of their elements are strings.
 func (r DNSRecordRaw) StringsFor(key string) (value []string, err error) {
-	return valueFor[[]string](r, key)
+	var v any
+	if v, err = r.lookup(key); err == nil {
+		switch v := v.(type) {
+		case []string:
+			return v, nil
+		case []any:
+			value = make([]string, 0, len(v))
+			for _, element := range v {
+				if str, ok := element.(string); ok {
+					value = append(value, str)
+				} else {
+					return nil, fmt.Errorf("value for key: '%s' has a non-string element: %v", key, element)
+				}
+			}
+			return value, nil
+		default:
+			return nil, fmt.Errorf("value for key: '%s' could not be converted to %T", key, value)
+		}
+	}
+
+	return nil, err
 }
 
 // IntFor returns the int value with given key string.
+//
+// Numbers decoded from JSON (`float64`) are converted when they have no fractional part.
 func (r DNSRecordRaw) IntFor(key string) (value int, err error) {
-	return valueFor[int](r, key)
+	var f float64
+	if f, err = r.FloatFor(key); err == nil {
+		if f != math.Trunc(f) {
+			return 0, fmt.Errorf("value for key: '%s' is not an integer: %v", key, f)
+		}
+		return int(f), nil
+	}
+
+	return 0, err
+}
+
+// FloatFor returns the float value with given key string.
+func (r DNSRecordRaw) FloatFor(key string) (value float64, err error) {
+	var v any
+	if v, err = r.lookup(key); err == nil {
+		switch v := v.(type) {
+		case float64:
+			return v, nil
+		case float32:
+			return float64(v), nil
+		case int:
+			return float64(v), nil
+		case int32:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		case json.Number:
+			return v.Float64()
+		default:
+			return 0, fmt.Errorf("value for key: '%s' could not be converted to %T", key, value)
+		}
+	}
+
+	return 0, err
+}
+
+// BoolFor returns the bool value with given key string. (eg. `proxied`, `settings.flatten_cname`)
+func (r DNSRecordRaw) BoolFor(key string) (value bool, err error) {
+	return valueFor[bool](r, key)
+}
+
+// TimeFor returns the time value with given key string. (eg. `created_on`, `modified_on`)
+//
+// String values are parsed in RFC3339 format.
+func (r DNSRecordRaw) TimeFor(key string) (value time.Time, err error) {
+	var v any
+	if v, err = r.lookup(key); err == nil {
+		switch v := v.(type) {
+		case time.Time:
+			return v, nil
+		case string:
+			if value, err = time.Parse(time.RFC3339Nano, v); err != nil {
+				return value, fmt.Errorf("value for key: '%s' could not be parsed as time: %s", key, err)
+			}
+			return value, nil
+		default:
+			return value, fmt.Errorf("value for key: '%s' could not be converted to %T", key, value)
+		}
+	}
+
+	return value, err
 }
 
 // GetType returns the type of DNSRecord.
@@ -184,50 +252,53 @@ type DNSRecordCommon struct {
 	ZoneName   string   `json:"zone_name,omitempty"`
 }
 
+// DNSRecordSettings struct for the settings of A, AAAA, and CNAME records
+//
+// Values are pointers for distinguishing explicit `false` values from unset ones.
+//
+// https://developers.cloudflare.com/dns/manage-dns-records/reference/record-attributes/
+type DNSRecordSettings struct {
+	IPv4Only     *bool `json:"ipv4_only,omitempty"`
+	IPv6Only     *bool `json:"ipv6_only,omitempty"`
+	FlattenCNAME *bool `json:"flatten_cname,omitempty"` // only for CNAME records
+}
+
+// returns given settings, initializing it if needed (shared by A, AAAA, and CNAME records)
+func settingsOf(settings **DNSRecordSettings) *DNSRecordSettings {
+	if *settings == nil {
+		*settings = &DNSRecordSettings{}
+	}
+	return *settings
+}
+
 // DNSRecordA struct for parsing A record
 type DNSRecordA struct {
 	DNSRecordCommon
 
-	Proxied bool `json:"proxied,omitempty"`
+	Proxied  bool               `json:"proxied"` // always sent, for keeping explicit `false` values
+	Settings *DNSRecordSettings `json:"settings,omitempty"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordA) SetID(recordID string) *DNSRecordA {
-	r.ID = recordID
-	return r
+// returns the proxied and settings values of DNS record
+func (r *DNSRecordA) proxiable() (proxied *bool, settings **DNSRecordSettings) {
+	return &r.Proxied, &r.Settings
 }
 
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordA) SetComment(comment string) *DNSRecordA {
-	r.Comment = comment
+// SetProxied sets the `proxied` value of DNS record.
+func (r *DNSRecordA) SetProxied(proxied bool) *DNSRecordA {
+	r.Proxied = proxied
 	return r
 }
 
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordA) SetTags(tags []string) *DNSRecordA {
-	r.Tags = tags
+// SetIPv4Only sets the `settings.ipv4_only` value of DNS record.
+func (r *DNSRecordA) SetIPv4Only(ipv4Only bool) *DNSRecordA {
+	settingsOf(&r.Settings).IPv4Only = &ipv4Only
 	return r
 }
 
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordA) SetTTL(ttl int) *DNSRecordA {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordA) SetZoneID(zoneID string) *DNSRecordA {
-	r.ZoneID = zoneID
+// SetIPv6Only sets the `settings.ipv6_only` value of DNS record.
+func (r *DNSRecordA) SetIPv6Only(ipv6Only bool) *DNSRecordA {
+	settingsOf(&r.Settings).IPv6Only = &ipv6Only
 	return r
 }
 
@@ -244,43 +315,26 @@ func NewDNSRecordA(name, content string) *DNSRecordA {
 // DNSRecordAAAA struct for parsing AAAA record
 type DNSRecordAAAA DNSRecordA
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordAAAA) SetID(recordID string) *DNSRecordAAAA {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordAAAA) SetComment(comment string) *DNSRecordAAAA {
-	r.Comment = comment
-	return r
+// returns the proxied and settings values of DNS record
+func (r *DNSRecordAAAA) proxiable() (proxied *bool, settings **DNSRecordSettings) {
+	return &r.Proxied, &r.Settings
 }
 
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordAAAA) SetTags(tags []string) *DNSRecordAAAA {
-	r.Tags = tags
+// SetProxied sets the `proxied` value of DNS record.
+func (r *DNSRecordAAAA) SetProxied(proxied bool) *DNSRecordAAAA {
+	r.Proxied = proxied
 	return r
 }
 
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordAAAA) SetTTL(ttl int) *DNSRecordAAAA {
-	r.TTL = ttl
+// SetIPv4Only sets the `settings.ipv4_only` value of DNS record.
+func (r *DNSRecordAAAA) SetIPv4Only(ipv4Only bool) *DNSRecordAAAA {
+	settingsOf(&r.Settings).IPv4Only = &ipv4Only
 	return r
 }
 
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordAAAA) SetZoneID(zoneID string) *DNSRecordAAAA {
-	r.ZoneID = zoneID
+// SetIPv6Only sets the `settings.ipv6_only` value of DNS record.
+func (r *DNSRecordAAAA) SetIPv6Only(ipv6Only bool) *DNSRecordAAAA {
+	settingsOf(&r.Settings).IPv6Only = &ipv6Only
 	return r
 }
 
@@ -305,46 +359,6 @@ type DNSRecordCAA struct {
 	} `json:"data"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCAA) SetID(recordID string) *DNSRecordCAA {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCAA) SetComment(comment string) *DNSRecordCAA {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCAA) SetTags(tags []string) *DNSRecordCAA {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCAA) SetTTL(ttl int) *DNSRecordCAA {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCAA) SetZoneID(zoneID string) *DNSRecordCAA {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordCAA creates a new CAA record.
 func NewDNSRecordCAA(name string, flags int, tag, value string) *DNSRecordCAA {
 	r := DNSRecordCAA{}
@@ -369,46 +383,6 @@ type DNSRecordCERT struct {
 	} `json:"data"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCERT) SetID(recordID string) *DNSRecordCERT {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCERT) SetComment(comment string) *DNSRecordCERT {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCERT) SetTags(tags []string) *DNSRecordCERT {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCERT) SetTTL(ttl int) *DNSRecordCERT {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCERT) SetZoneID(zoneID string) *DNSRecordCERT {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordCERT creates a new CERT record.
 func NewDNSRecordCERT(name string, algorithm int, certificate string, keyTag, typ3 int) *DNSRecordCERT {
 	r := DNSRecordCERT{}
@@ -426,46 +400,36 @@ func NewDNSRecordCERT(name string, algorithm int, certificate string, keyTag, ty
 type DNSRecordCNAME struct {
 	DNSRecordCommon
 
-	Proxied bool `json:"proxied,omitempty"`
+	Proxied  bool               `json:"proxied"` // always sent, for keeping explicit `false` values
+	Settings *DNSRecordSettings `json:"settings,omitempty"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCNAME) SetID(recordID string) *DNSRecordCNAME {
-	r.ID = recordID
-	return r
+// returns the proxied and settings values of DNS record
+func (r *DNSRecordCNAME) proxiable() (proxied *bool, settings **DNSRecordSettings) {
+	return &r.Proxied, &r.Settings
 }
 
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCNAME) SetComment(comment string) *DNSRecordCNAME {
-	r.Comment = comment
+// SetProxied sets the `proxied` value of DNS record.
+func (r *DNSRecordCNAME) SetProxied(proxied bool) *DNSRecordCNAME {
+	r.Proxied = proxied
 	return r
 }
 
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCNAME) SetTags(tags []string) *DNSRecordCNAME {
-	r.Tags = tags
+// SetIPv4Only sets the `settings.ipv4_only` value of DNS record.
+func (r *DNSRecordCNAME) SetIPv4Only(ipv4Only bool) *DNSRecordCNAME {
+	settingsOf(&r.Settings).IPv4Only = &ipv4Only
 	return r
 }
 
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCNAME) SetTTL(ttl int) *DNSRecordCNAME {
-	r.TTL = ttl
+// SetIPv6Only sets the `settings.ipv6_only` value of DNS record.
+func (r *DNSRecordCNAME) SetIPv6Only(ipv6Only bool) *DNSRecordCNAME {
+	settingsOf(&r.Settings).IPv6Only = &ipv6Only
 	return r
 }
 
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordCNAME) SetZoneID(zoneID string) *DNSRecordCNAME {
-	r.ZoneID = zoneID
+// SetFlattenCNAME sets the `settings.flatten_cname` value of DNS record.
+func (r *DNSRecordCNAME) SetFlattenCNAME(flatten bool) *DNSRecordCNAME {
+	settingsOf(&r.Settings).FlattenCNAME = &flatten
 	return r
 }
 
@@ -491,46 +455,6 @@ type DNSRecordDNSKEY struct {
 	} `json:"data"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordDNSKEY) SetID(recordID string) *DNSRecordDNSKEY {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordDNSKEY) SetComment(comment string) *DNSRecordDNSKEY {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordDNSKEY) SetTags(tags []string) *DNSRecordDNSKEY {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordDNSKEY) SetTTL(ttl int) *DNSRecordDNSKEY {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordDNSKEY) SetZoneID(zoneID string) *DNSRecordDNSKEY {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordDNSKEY creates a new DNSKEY record.
 func NewDNSRecordDNSKEY(name string, algorithm, flags, protocl int, publicKey string) *DNSRecordDNSKEY {
 	r := DNSRecordDNSKEY{}
@@ -556,46 +480,6 @@ type DNSRecordDS struct {
 	} `json:"data"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordDS) SetID(recordID string) *DNSRecordDS {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordDS) SetComment(comment string) *DNSRecordDS {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordDS) SetTags(tags []string) *DNSRecordDS {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordDS) SetTTL(ttl int) *DNSRecordDS {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordDS) SetZoneID(zoneID string) *DNSRecordDS {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordDS creates a new DS record.
 func NewDNSRecordDS(name string, algorithm int, digest string, digestType, keyTag int) *DNSRecordDS {
 	r := DNSRecordDS{}
@@ -620,46 +504,6 @@ type DNSRecordHTTPS struct {
 	} `json:"data"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordHTTPS) SetID(recordID string) *DNSRecordHTTPS {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordHTTPS) SetComment(comment string) *DNSRecordHTTPS {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordHTTPS) SetTags(tags []string) *DNSRecordHTTPS {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordHTTPS) SetTTL(ttl int) *DNSRecordHTTPS {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordHTTPS) SetZoneID(zoneID string) *DNSRecordHTTPS {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordHTTPS creates a new HTTPS record.
 func NewDNSRecordHTTPS(name string, priority int, target, value string) *DNSRecordHTTPS {
 	r := DNSRecordHTTPS{}
@@ -706,46 +550,6 @@ type DNSRecordLOC struct {
 	} `json:"data"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordLOC) SetID(recordID string) *DNSRecordLOC {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordLOC) SetComment(comment string) *DNSRecordLOC {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordLOC) SetTags(tags []string) *DNSRecordLOC {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordLOC) SetTTL(ttl int) *DNSRecordLOC {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordLOC) SetZoneID(zoneID string) *DNSRecordLOC {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordLOC creates a new LOC record.
 func NewDNSRecordLOC(name string, altitude, latDeg int, latDir LatitudeDirection, latMin, latSec, longDeg int, longDir LongitudeDirection, longMin, longSec, precHorizontal, precVertical, size int) *DNSRecordLOC {
 	r := DNSRecordLOC{}
@@ -774,46 +578,6 @@ type DNSRecordMX struct {
 	Priority int `json:"priority"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordMX) SetID(recordID string) *DNSRecordMX {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordMX) SetComment(comment string) *DNSRecordMX {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordMX) SetTags(tags []string) *DNSRecordMX {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordMX) SetTTL(ttl int) *DNSRecordMX {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordMX) SetZoneID(zoneID string) *DNSRecordMX {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordMX creates a new MX record.
 func NewDNSRecordMX(name, content string, priority int) *DNSRecordMX {
 	r := DNSRecordMX{}
@@ -839,46 +603,6 @@ type DNSRecordNAPTR struct {
 	} `json:"data"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordNAPTR) SetID(recordID string) *DNSRecordNAPTR {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordNAPTR) SetComment(comment string) *DNSRecordNAPTR {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordNAPTR) SetTags(tags []string) *DNSRecordNAPTR {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordNAPTR) SetTTL(ttl int) *DNSRecordNAPTR {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordNAPTR) SetZoneID(zoneID string) *DNSRecordNAPTR {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordNAPTR creates a new NAPTR record.
 func NewDNSRecordNAPTR(name, flags string, order, preference int, regex, replacement, service string) *DNSRecordNAPTR {
 	r := DNSRecordNAPTR{}
@@ -897,46 +621,6 @@ func NewDNSRecordNAPTR(name, flags string, order, preference int, regex, replace
 // DNSRecordNS struct for parsing NS record
 type DNSRecordNS DNSRecordCommon
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordNS) SetID(recordID string) *DNSRecordNS {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordNS) SetComment(comment string) *DNSRecordNS {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordNS) SetTags(tags []string) *DNSRecordNS {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordNS) SetTTL(ttl int) *DNSRecordNS {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordNS) SetZoneID(zoneID string) *DNSRecordNS {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordNS creates a new NS record.
 func NewDNSRecordNS(name, content string) *DNSRecordNS {
 	r := DNSRecordNS{}
@@ -950,46 +634,6 @@ func NewDNSRecordNS(name, content string) *DNSRecordNS {
 // DNSRecordPTR struct for parsing PTR record
 type DNSRecordPTR DNSRecordCommon
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordPTR) SetID(recordID string) *DNSRecordPTR {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordPTR) SetComment(comment string) *DNSRecordPTR {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordPTR) SetTags(tags []string) *DNSRecordPTR {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordPTR) SetTTL(ttl int) *DNSRecordPTR {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordPTR) SetZoneID(zoneID string) *DNSRecordPTR {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordPTR creates a new PTR record.
 func NewDNSRecordPTR(name, content string) *DNSRecordPTR {
 	r := DNSRecordPTR{}
@@ -1012,46 +656,6 @@ type DNSRecordSMIMEA struct {
 	} `json:"data"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSMIMEA) SetID(recordID string) *DNSRecordSMIMEA {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSMIMEA) SetComment(comment string) *DNSRecordSMIMEA {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSMIMEA) SetTags(tags []string) *DNSRecordSMIMEA {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSMIMEA) SetTTL(ttl int) *DNSRecordSMIMEA {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSMIMEA) SetZoneID(zoneID string) *DNSRecordSMIMEA {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordSMIMEA creates a new SMIMEA record.
 func NewDNSRecordSMIMEA(name, certificate string, matchingType, selector, usage int) *DNSRecordSMIMEA {
 	r := DNSRecordSMIMEA{}
@@ -1080,46 +684,6 @@ type DNSRecordSRV struct {
 	} `json:"data"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSRV) SetID(recordID string) *DNSRecordSRV {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSRV) SetComment(comment string) *DNSRecordSRV {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSRV) SetTags(tags []string) *DNSRecordSRV {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSRV) SetTTL(ttl int) *DNSRecordSRV {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSRV) SetZoneID(zoneID string) *DNSRecordSRV {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordSRV creates a new SRV record.
 func NewDNSRecordSRV(name string, port, priority int, proto, service, target string, weight int) *DNSRecordSRV {
 	r := DNSRecordSRV{}
@@ -1147,46 +711,6 @@ type DNSRecordSSHFP struct {
 	} `json:"data"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSSHFP) SetID(recordID string) *DNSRecordSSHFP {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSSHFP) SetComment(comment string) *DNSRecordSSHFP {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSSHFP) SetTags(tags []string) *DNSRecordSSHFP {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSSHFP) SetTTL(ttl int) *DNSRecordSSHFP {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSSHFP) SetZoneID(zoneID string) *DNSRecordSSHFP {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordSSHFP creates a new SSHFP record.
 func NewDNSRecordSSHFP(name string, algorithm int, fingerprint string, typ3 int) *DNSRecordSSHFP {
 	r := DNSRecordSSHFP{}
@@ -1210,46 +734,6 @@ type DNSRecordSVCB struct {
 	} `json:"data"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSVCB) SetID(recordID string) *DNSRecordSVCB {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSVCB) SetComment(comment string) *DNSRecordSVCB {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSVCB) SetTags(tags []string) *DNSRecordSVCB {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSVCB) SetTTL(ttl int) *DNSRecordSVCB {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordSVCB) SetZoneID(zoneID string) *DNSRecordSVCB {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordSVCB creates a new SVCB record.
 func NewDNSRecordSVCB(name string, priority int, target, value string) *DNSRecordSVCB {
 	r := DNSRecordSVCB{}
@@ -1274,46 +758,6 @@ type DNSRecordTLSA struct {
 	} `json:"data"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordTLSA) SetID(recordID string) *DNSRecordTLSA {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordTLSA) SetComment(comment string) *DNSRecordTLSA {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordTLSA) SetTags(tags []string) *DNSRecordTLSA {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordTLSA) SetTTL(ttl int) *DNSRecordTLSA {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordTLSA) SetZoneID(zoneID string) *DNSRecordTLSA {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordTLSA creates a new TLSA record.
 func NewDNSRecordTLSA(name, certificate string, matchingType, selector, usage int) *DNSRecordTLSA {
 	r := DNSRecordTLSA{}
@@ -1330,46 +774,6 @@ func NewDNSRecordTLSA(name, certificate string, matchingType, selector, usage in
 // DNSRecordTXT struct for parsing TXT record
 type DNSRecordTXT DNSRecordCommon
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordTXT) SetID(recordID string) *DNSRecordTXT {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordTXT) SetComment(comment string) *DNSRecordTXT {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordTXT) SetTags(tags []string) *DNSRecordTXT {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordTXT) SetTTL(ttl int) *DNSRecordTXT {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordTXT) SetZoneID(zoneID string) *DNSRecordTXT {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordTXT creates a new TXT record.
 func NewDNSRecordTXT(name, content string) *DNSRecordTXT {
 	r := DNSRecordTXT{}
@@ -1391,46 +795,6 @@ type DNSRecordURI struct {
 	Priority int `json:"priority"`
 }
 
-// SetID sets the `id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordURI) SetID(recordID string) *DNSRecordURI {
-	r.ID = recordID
-	return r
-}
-
-// SetComment sets the `comment` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordURI) SetComment(comment string) *DNSRecordURI {
-	r.Comment = comment
-	return r
-}
-
-// SetTags sets the `tags` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordURI) SetTags(tags []string) *DNSRecordURI {
-	r.Tags = tags
-	return r
-}
-
-// SetTTL sets the `ttl` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordURI) SetTTL(ttl int) *DNSRecordURI {
-	r.TTL = ttl
-	return r
-}
-
-// SetZoneID sets the `zone_id` value of DNS record.
-//
-// FIXME: repeated all over the record types
-func (r *DNSRecordURI) SetZoneID(zoneID string) *DNSRecordURI {
-	r.ZoneID = zoneID
-	return r
-}
-
 // NewDNSRecordURI creates a new URI record.
 func NewDNSRecordURI(name, content string, weight int) *DNSRecordURI {
 	r := DNSRecordURI{}
@@ -1447,12 +811,24 @@ type ResponseDNSRecords struct {
 	ResponseCommon
 
 	Result     []DNSRecordRaw `json:"result"`
-	ResultInfo struct {
-		Count      int `json:"count,omitempty"`
-		Page       int `json:"page,omitempty"`
-		PerPage    int `json:"per_page,omitempty"`
-		TotalCount int `json:"total_count,omitempty"`
-	} `json:"result_info,omitempty"`
+	ResultInfo ResultInfo     `json:"result_info,omitempty"`
+}
+
+// TypedResult returns the results as typed records. (eg. `*DNSRecordMX`)
+func (r ResponseDNSRecords) TypedResult() ([]DNSRecord, error) {
+	return DNSRecordsRaw(r.Result).Typed()
+}
+
+// ResponseDNSRecordDetails struct for the responses of `GetDNSRecord` function
+type ResponseDNSRecordDetails struct {
+	ResponseCommon
+
+	Result DNSRecordRaw `json:"result"`
+}
+
+// TypedResult returns the result as a typed record. (eg. `*DNSRecordMX`)
+func (r ResponseDNSRecordDetails) TypedResult() (DNSRecord, error) {
+	return r.Result.Typed()
 }
 
 // ResponseDNSRecordCreation struct for the responses of `CreateDNSRecord` function
@@ -1462,6 +838,11 @@ type ResponseDNSRecordCreation struct {
 	Result DNSRecordRaw `json:"result"`
 }
 
+// TypedResult returns the result as a typed record. (eg. `*DNSRecordMX`)
+func (r ResponseDNSRecordCreation) TypedResult() (DNSRecord, error) {
+	return r.Result.Typed()
+}
+
 // ResponseDNSRecordDeletion struct for the responses of `DeleteDNSRecord` function
 type ResponseDNSRecordDeletion struct {
 	Result struct {
@@ -1475,3 +856,201 @@ type ResponseDNSRecordUpdate struct {
 
 	Result DNSRecordRaw `json:"result"`
 }
+
+// TypedResult returns the result as a typed record. (eg. `*DNSRecordMX`)
+func (r ResponseDNSRecordUpdate) TypedResult() (DNSRecord, error) {
+	return r.Result.Typed()
+}
+
+// ResponseDNSRecordUpsert struct for the responses of `UpsertDNSRecord` function
+type ResponseDNSRecordUpsert struct {
+	ResponseCommon
+
+	Result DNSRecordRaw `json:"result"`
+
+	Created bool `json:"-"` // whether the record was newly created (or updated)
+}
+
+// TypedResult returns the result as a typed record. (eg. `*DNSRecordMX`)
+func (r ResponseDNSRecordUpsert) TypedResult() (DNSRecord, error) {
+	return r.Result.Typed()
+}
+
+// DNSRecordsImportOptions struct for options of importing DNS records from a zone file
+type DNSRecordsImportOptions struct {
+	Proxied bool // whether proxiable records (A, AAAA, and CNAME) will be proxied
+}
+
+// ResponseDNSRecordsImport struct for the responses of `ImportDNSRecords` function
+type ResponseDNSRecordsImport struct {
+	ResponseCommon
+
+	Result struct {
+		RecsAdded          int `json:"recs_added"`           // number of records added
+		TotalRecordsParsed int `json:"total_records_parsed"` // number of records parsed from the zone file
+	} `json:"result"`
+}
+
+// ResponseDNSRecordPatch struct for the responses of `PatchDNSRecord` function
+type ResponseDNSRecordPatch struct {
+	ResponseCommon
+
+	Result DNSRecordRaw `json:"result"`
+}
+
+// TypedResult returns the result as a typed record. (eg. `*DNSRecordMX`)
+func (r ResponseDNSRecordPatch) TypedResult() (DNSRecord, error) {
+	return r.Result.Typed()
+}
+
+// DNSRecordPatch for the sparse fields of a DNS record to be patched
+//
+// Nested fields can be set with dotted keys, eg. `data.priority` or `settings.flatten_cname`.
+type DNSRecordPatch map[string]any
+
+// NewDNSRecordPatch returns a new, empty patch.
+func NewDNSRecordPatch() DNSRecordPatch {
+	return DNSRecordPatch{}
+}
+
+// Set sets the value for given (dotted) key.
+func (p DNSRecordPatch) Set(key string, value any) DNSRecordPatch {
+	p[key] = value
+	return p
+}
+
+// MarshalJSON encodes the patch with its dotted keys expanded into nested objects.
+//
+// Values of objects (eg. structs, or maps of other types) are merged with the ones of dotted keys,
+// and an error is returned if they conflict.
+func (p DNSRecordPatch) MarshalJSON() ([]byte, error) {
+	expanded, err := expandDottedKeys(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(expanded)
+}
+
+// expands dotted keys of given map into nested maps, in the order of keys
+//
+// eg. {"data.priority": 10} => {"data": {"priority": 10}}
+func expandDottedKeys(m map[string]any) (expanded map[string]any, err error) {
+	expanded = map[string]any{}
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		keys := strings.Split(key, ".")
+
+		current := expanded
+		for i, k := range keys[:len(keys)-1] {
+			if _, exists := current[k]; !exists {
+				current[k] = map[string]any{}
+			}
+			next, ok := current[k].(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("failed to expand key '%s': '%s' is not an object", key, strings.Join(keys[:i+1], "."))
+			}
+			current = next
+		}
+
+		last := keys[len(keys)-1]
+		var value any
+		if value, err = asObject(m[key]); err != nil {
+			return nil, fmt.Errorf("failed to expand key '%s': %s", key, err)
+		}
+		if nested, ok := value.(map[string]any); ok {
+			if nested, err = expandDottedKeys(nested); err != nil {
+				return nil, err
+			}
+			if existing, exists := current[last]; exists {
+				// merge with already-expanded values
+				if err = mergeObjects(existing, nested, key); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			value = nested
+		} else if _, exists := current[last]; exists {
+			return nil, fmt.Errorf("failed to expand key '%s': conflicts with other keys", key)
+		}
+		current[last] = value
+	}
+
+	return expanded, nil
+}
+
+// returns given value as a `map[string]any` if it is encoded as a JSON object (eg. structs, or maps of other types),
+// or as it is if not
+func asObject(value any) (any, error) {
+	if _, ok := value.(map[string]any); ok || value == nil {
+		return value, nil
+	}
+
+	switch reflect.Indirect(reflect.ValueOf(value)).Kind() {
+	case reflect.Map, reflect.Struct:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		var object map[string]any
+		if err := json.Unmarshal(encoded, &object); err != nil {
+			return value, nil // not encoded as an object (eg. with a custom marshaler)
+		}
+		return object, nil
+	}
+
+	return value, nil
+}
+
+// merges values of `src` into `dst` (an object), returning an error on conflicting values
+func mergeObjects(dst any, src map[string]any, key string) error {
+	object, ok := dst.(map[string]any)
+	if !ok {
+		return fmt.Errorf("failed to expand key '%s': conflicts with a non-object value", key)
+	}
+
+	for k, v := range src {
+		existing, exists := object[k]
+		if !exists {
+			object[k] = v
+			continue
+		}
+
+		nested, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("failed to expand key '%s': '%s' is set more than once", key, key+"."+k)
+		}
+		if err := mergeObjects(existing, nested, key+"."+k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResponseDNSRecordsBatch struct for the responses of `BatchDNSRecords` function
+type ResponseDNSRecordsBatch struct {
+	ResponseCommon
+
+	Result struct {
+		Deletes []DNSRecordRaw `json:"deletes,omitempty"`
+		Patches []DNSRecordRaw `json:"patches,omitempty"`
+		Puts    []DNSRecordRaw `json:"puts,omitempty"`
+		Posts   []DNSRecordRaw `json:"posts,omitempty"`
+	} `json:"result"`
+}
+
+// TypedResults returns the results of posts, puts, and patches as typed records. (eg. `*DNSRecordMX`)
+func (r ResponseDNSRecordsBatch) TypedResults() (posts, puts, patches []DNSRecord, err error) {
+	if posts, err = DNSRecordsRaw(r.Result.Posts).Typed(); err != nil {
+		return nil, nil, nil, err
+	}
+	if puts, err = DNSRecordsRaw(r.Result.Puts).Typed(); err != nil {
+		return nil, nil, nil, err
+	}
+	if patches, err = DNSRecordsRaw(r.Result.Patches).Typed(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return posts, puts, patches, nil
+}
@@ -0,0 +1,453 @@
+package cfgo
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+const (
+	minTTL = 60
+	maxTTL = 86400
+
+	maxNameLength  = 253
+	maxLabelLength = 63
+
+	maxTXTContentLength = 2048
+
+	maxUint8  = 255
+	maxUint16 = 65535
+)
+
+// tags of CAA records
+var caaTags = []string{
+	"issue",
+	"issuewild",
+	"issuemail",
+	"issuevmc",
+	"iodef",
+	"contactemail",
+}
+
+// ValidationError struct for an invalid field of DNS record
+type ValidationError struct {
+	Type    DNSRecordType
+	Field   string
+	Message string
+}
+
+// Error returns the message of this error.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s record: `%s` %s", e.Type, e.Field, e.Message)
+}
+
+// collects validation errors of a DNS record
+type validator struct {
+	typ3   DNSRecordType
+	errors []error
+}
+
+// returns a new validator for given type of record
+func newValidator(typ3 DNSRecordType) *validator {
+	return &validator{
+		typ3: typ3,
+	}
+}
+
+// adds an error for given field if the condition is not met
+func (v *validator) check(ok bool, field, format string, args ...any) *validator {
+	if !ok {
+		v.errors = append(v.errors, &ValidationError{
+			Type:    v.typ3,
+			Field:   field,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+	return v
+}
+
+// checks if the value of given field is in [minimum, maximum]
+func (v *validator) inRange(value int, field string, minimum, maximum int) *validator {
+	return v.check(value >= minimum && value <= maximum, field, "should be in range [%d, %d], but was %d", minimum, maximum, value)
+}
+
+// checks if the value of given field is a valid hostname
+func (v *validator) hostname(value, field string) *validator {
+	return v.check(isValidHostname(value), field, "is not a valid hostname: '%s'", value)
+}
+
+// checks if the value of given field is a valid hostname or "." (for no target)
+func (v *validator) target(value, field string) *validator {
+	return v.check(value == "." || isValidHostname(value), field, "is not a valid target: '%s'", value)
+}
+
+// checks if the value of given field is a non-empty hex string
+func (v *validator) hex(value, field string) *validator {
+	_, err := hex.DecodeString(value)
+	return v.check(value != "" && err == nil, field, "should be a hex string, but was '%s'", value)
+}
+
+// checks if the value of given field is not empty
+func (v *validator) required(value, field string) *validator {
+	return v.check(value != "", field, "is missing")
+}
+
+// checks the common values of a DNS record
+func (v *validator) common(r *DNSRecordCommon) *validator {
+	if v.typ3 != Undefined {
+		v.check(r.Type == v.typ3, "type", "should be '%s', but was '%s'", v.typ3, r.Type)
+	} else {
+		v.check(r.Type != Undefined, "type", "is missing")
+	}
+
+	return v.
+		check(r.Name == "@" || isValidName(r.Name), "name", "is not a valid DNS name: '%s'", r.Name).
+		check(r.TTL == 0 || r.TTL == 1 || (r.TTL >= minTTL && r.TTL <= maxTTL), "ttl", "should be 1 (automatic) or in range [%d, %d], but was %d", minTTL, maxTTL, r.TTL)
+}
+
+// checks the settings of a DNS record
+func (v *validator) settings(settings *DNSRecordSettings) *validator {
+	if settings == nil {
+		return v
+	}
+
+	return v.
+		check(!(isTrue(settings.IPv4Only) && isTrue(settings.IPv6Only)), "settings", "should not have both `ipv4_only` and `ipv6_only` enabled").
+		check(settings.FlattenCNAME == nil || v.typ3 == CNAME, "settings.flatten_cname", "is only for CNAME records")
+}
+
+// checks if given bool pointer is set to true
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
+
+// returns all collected errors (or nil if there was none)
+func (v *validator) err() error {
+	return errors.Join(v.errors...)
+}
+
+// returns given domain name with its internationalized labels (eg. `bücher`) converted to A-labels (eg. `xn--bcher-kva`)
+//
+// ASCII labels are left as they are, so that wildcards and underscores can be validated separately.
+func toASCII(name string) (string, bool) {
+	labels := strings.Split(name, ".")
+	for i, label := range labels {
+		if isASCII(label) {
+			continue
+		}
+
+		converted, err := idna.Lookup.ToASCII(label)
+		if err != nil {
+			return name, false
+		}
+		labels[i] = converted
+	}
+
+	return strings.Join(labels, "."), true
+}
+
+// checks if given string has only ASCII characters
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checks if given string is a valid DNS name (wildcards, underscores, and internationalized labels are allowed)
+func isValidName(name string) bool {
+	name, ok := toASCII(strings.TrimSuffix(name, "."))
+	if !ok || name == "" || len(name) > maxNameLength {
+		return false
+	}
+
+	for i, label := range strings.Split(name, ".") {
+		if i == 0 && label == "*" {
+			continue
+		}
+		if !isValidLabel(label, true) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checks if given string is a valid hostname (eg. content of CNAME, MX, NS records), or an internationalized one
+func isValidHostname(hostname string) bool {
+	hostname, ok := toASCII(strings.TrimSuffix(hostname, "."))
+	if !ok || hostname == "" || len(hostname) > maxNameLength {
+		return false
+	}
+
+	for _, label := range strings.Split(hostname, ".") {
+		if !isValidLabel(label, false) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checks if given string is a valid label of DNS name
+func isValidLabel(label string, allowUnderscore bool) bool {
+	if len(label) == 0 || len(label) > maxLabelLength ||
+		strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		return false
+	}
+
+	for _, c := range label {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-':
+			continue
+		case c == '_' && allowUnderscore:
+			continue
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// checks if given string is an IPv4 address
+func isIPv4(s string) bool {
+	addr, err := netip.ParseAddr(s)
+	return err == nil && addr.Is4()
+}
+
+// checks if given string is an IPv6 address
+func isIPv6(s string) bool {
+	addr, err := netip.ParseAddr(s)
+	return err == nil && addr.Is6()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordA) Validate() error {
+	return newValidator(A).
+		common(&r.DNSRecordCommon).
+		settings(r.Settings).
+		check(isIPv4(r.Content), "content", "is not an IPv4 address: '%s'", r.Content).
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordAAAA) Validate() error {
+	return newValidator(AAAA).
+		common(&r.DNSRecordCommon).
+		settings(r.Settings).
+		check(isIPv6(r.Content), "content", "is not an IPv6 address: '%s'", r.Content).
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordCAA) Validate() error {
+	return newValidator(CAA).
+		common(&r.DNSRecordCommon).
+		inRange(r.Data.Flags, "data.flags", 0, maxUint8).
+		check(slices.Contains(caaTags, r.Data.Tag), "data.tag", "should be one of %v, but was '%s'", caaTags, r.Data.Tag).
+		required(r.Data.Value, "data.value").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordCERT) Validate() error {
+	return newValidator(CERT).
+		common(&r.DNSRecordCommon).
+		inRange(r.Data.Algorithm, "data.algorithm", 0, maxUint8).
+		inRange(r.Data.KeyTag, "data.key_tag", 0, maxUint16).
+		inRange(r.Data.Type, "data.type", 0, maxUint16).
+		required(r.Data.Certificate, "data.certificate").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordCNAME) Validate() error {
+	return newValidator(CNAME).
+		common(&r.DNSRecordCommon).
+		settings(r.Settings).
+		hostname(r.Content, "content").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordDNSKEY) Validate() error {
+	return newValidator(DNSKEY).
+		common(&r.DNSRecordCommon).
+		inRange(r.Data.Algorithm, "data.algorithm", 0, maxUint8).
+		inRange(r.Data.Flags, "data.flags", 0, maxUint16).
+		check(r.Data.Protocol == 3, "data.protocol", "should be 3, but was %d", r.Data.Protocol).
+		required(r.Data.PublicKey, "data.public_key").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordDS) Validate() error {
+	return newValidator(DS).
+		common(&r.DNSRecordCommon).
+		inRange(r.Data.Algorithm, "data.algorithm", 0, maxUint8).
+		inRange(r.Data.DigestType, "data.digest_type", 0, maxUint8).
+		inRange(r.Data.KeyTag, "data.key_tag", 0, maxUint16).
+		hex(r.Data.Digest, "data.digest").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordHTTPS) Validate() error {
+	return newValidator(HTTPS).
+		common(&r.DNSRecordCommon).
+		inRange(r.Data.Priority, "data.priority", 0, maxUint16).
+		target(r.Data.Target, "data.target").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordLOC) Validate() error {
+	return newValidator(LOC).
+		common(&r.DNSRecordCommon).
+		inRange(r.Data.LatDegrees, "data.lat_degrees", 0, 90).
+		inRange(r.Data.LatMinutes, "data.lat_minutes", 0, 59).
+		inRange(r.Data.LatSeconds, "data.lat_seconds", 0, 59).
+		check(r.Data.LatDirection == North || r.Data.LatDirection == South, "data.lat_direction", "should be '%s' or '%s', but was '%s'", North, South, r.Data.LatDirection).
+		inRange(r.Data.LongDegrees, "data.long_degrees", 0, 180).
+		inRange(r.Data.LongMinutes, "data.long_minutes", 0, 59).
+		inRange(r.Data.LongSeconds, "data.long_seconds", 0, 59).
+		check(r.Data.LongDirection == East || r.Data.LongDirection == West, "data.long_direction", "should be '%s' or '%s', but was '%s'", East, West, r.Data.LongDirection).
+		inRange(r.Data.Altitude, "data.altitude", -100000, 42849672).
+		inRange(r.Data.Size, "data.size", 0, 90000000).
+		inRange(r.Data.PrecisionHorizontal, "data.precision_horz", 0, 90000000).
+		inRange(r.Data.PrecisionVertical, "data.precision_vert", 0, 90000000).
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordMX) Validate() error {
+	return newValidator(MX).
+		common(&r.DNSRecordCommon).
+		target(r.Content, "content").
+		inRange(r.Priority, "priority", 0, maxUint16).
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordNAPTR) Validate() error {
+	return newValidator(NAPTR).
+		common(&r.DNSRecordCommon).
+		inRange(r.Data.Order, "data.order", 0, maxUint16).
+		inRange(r.Data.Preference, "data.preference", 0, maxUint16).
+		target(r.Data.Replacement, "data.replacement").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordNS) Validate() error {
+	return newValidator(NS).
+		common(r.Common()).
+		hostname(r.Content, "content").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordPTR) Validate() error {
+	return newValidator(PTR).
+		common(r.Common()).
+		hostname(r.Content, "content").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordSMIMEA) Validate() error {
+	return newValidator(SMIMEA).
+		common(&r.DNSRecordCommon).
+		inRange(r.Data.Usage, "data.usage", 0, 3).
+		inRange(r.Data.Selector, "data.selector", 0, 1).
+		inRange(r.Data.MatchingType, "data.matching_type", 0, 2).
+		hex(r.Data.Certificate, "data.certificate").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordSRV) Validate() error {
+	return newValidator(SRV).
+		common(&r.DNSRecordCommon).
+		inRange(r.Data.Priority, "data.priority", 0, maxUint16).
+		inRange(r.Data.Weight, "data.weight", 0, maxUint16).
+		inRange(r.Data.Port, "data.port", 0, maxUint16).
+		target(r.Data.Target, "data.target").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordSSHFP) Validate() error {
+	return newValidator(SSHFP).
+		common(&r.DNSRecordCommon).
+		inRange(r.Data.Algorithm, "data.algorithm", 0, maxUint8).
+		inRange(r.Data.Type, "data.type", 0, maxUint8).
+		hex(r.Data.Fingerprint, "data.fingerprint").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordSVCB) Validate() error {
+	return newValidator(SVCB).
+		common(&r.DNSRecordCommon).
+		inRange(r.Data.Priority, "data.priority", 0, maxUint16).
+		target(r.Data.Target, "data.target").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordTLSA) Validate() error {
+	return newValidator(TLSA).
+		common(&r.DNSRecordCommon).
+		inRange(r.Data.Usage, "data.usage", 0, 3).
+		inRange(r.Data.Selector, "data.selector", 0, 1).
+		inRange(r.Data.MatchingType, "data.matching_type", 0, 2).
+		hex(r.Data.Certificate, "data.certificate").
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordTXT) Validate() error {
+	return newValidator(TXT).
+		common(r.Common()).
+		required(r.Content, "content").
+		check(len(r.Content) <= maxTXTContentLength, "content", "should not be longer than %d characters, but was %d", maxTXTContentLength, len(r.Content)).
+		err()
+}
+
+// Validate checks the values of DNS record.
+func (r *DNSRecordURI) Validate() error {
+	return newValidator(URI).
+		common(&r.DNSRecordCommon).
+		inRange(r.Priority, "priority", 0, maxUint16).
+		inRange(r.Data.Weight, "data.weight", 0, maxUint16).
+		required(r.Data.Content, "data.content").
+		err()
+}
+
+// Validate checks the values of DNS record, as its typed one.
+//
+// Only the common values are checked for records of unknown types.
+func (r DNSRecordRaw) Validate() (err error) {
+	var typed DNSRecord
+	if typed, err = r.Typed(); err != nil {
+		return err
+	}
+
+	if raw, ok := typed.(DNSRecordRaw); ok {
+		return newValidator(Undefined).
+			common(raw.Common()).
+			err()
+	}
+
+	return typed.Validate()
+}
@@ -0,0 +1,67 @@
+package cfgo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	valid := []DNSRecord{
+		NewDNSRecordA("example.com", "192.168.0.1"),
+		NewDNSRecordAAAA("*.example.com", "2001:db8::1"),
+		NewDNSRecordCAA("example.com", 0, "issue", "letsencrypt.org"),
+		NewDNSRecordCAA("example.com", 0, "issuemail", "letsencrypt.org"),
+		NewDNSRecordCAA("example.com", 0, "contactemail", "security@example.com"),
+		NewDNSRecordA("bücher.example.com", "192.168.0.2"),
+		NewDNSRecordCNAME("www.例え.jp", "例え.jp"),
+		NewDNSRecordMX("xn--bcher-kva.example.com", "メール.example.com", 10),
+		NewRecordBuilder(NewDNSRecordCNAME("www", "example.com")).TTL(1).Record(),
+		NewDNSRecordDS("example.com", 13, "0123456789abcdef", 2, 12345),
+		NewRecordBuilder(NewDNSRecordMX("example.com", "mail.example.com", 10)).TTL(3600).Record(),
+		NewDNSRecordNS("sub.example.com", "ns1.example.com"),
+		NewDNSRecordSRV("_sip._tcp.example.com", 5060, 10, "_tcp", "_sip", "sip.example.com", 5),
+		NewDNSRecordTXT("_dmarc.example.com", "v=DMARC1; p=none"),
+		DNSRecordRaw{"type": "A", "name": "@", "content": "10.0.0.1", "ttl": float64(300)},
+		DNSRecordRaw{"type": "OPENPGPKEY", "name": "example.com", "content": "key"},
+	}
+	for _, record := range valid {
+		if err := record.Validate(); err != nil {
+			t.Errorf("[%s] record '%s' should be valid, but: %s", record.GetType(), record.GetName(), err)
+		}
+	}
+
+	invalid := []struct {
+		field  string
+		record DNSRecord
+	}{
+		{"content", NewDNSRecordA("example.com", "example.com")},
+		{"content", NewDNSRecordAAAA("example.com", "192.168.0.1")},
+		{"ttl", NewRecordBuilder(NewDNSRecordA("example.com", "192.168.0.1")).TTL(30).Record()},
+		{"name", NewDNSRecordCNAME("-invalid-.example.com", "example.com")},
+		{"name", NewDNSRecordTXT(strings.Repeat("a", 64)+".example.com", "too long label")},
+		{"name", NewDNSRecordA("bü cher.example.com", "192.168.0.1")},
+		{"content", NewDNSRecordCNAME("www.example.com", "-bücher-.example.com")},
+		{"priority", NewDNSRecordMX("example.com", "mail.example.com", 70000)},
+		{"data.tag", NewDNSRecordCAA("example.com", 0, "unknown", "letsencrypt.org")},
+		{"data.digest", NewDNSRecordDS("example.com", 13, "not-a-hex-digest", 2, 12345)},
+		{"data.port", NewDNSRecordSRV("_sip._tcp.example.com", 70000, 10, "_tcp", "_sip", "sip.example.com", 5)},
+		{"data.fingerprint", NewDNSRecordSSHFP("example.com", 4, "xyz", 2)},
+		{"data.certificate", NewDNSRecordTLSA("example.com", "--certificate--", 0, 1, 3)},
+		{"type", DNSRecordRaw{"name": "example.com"}},
+	}
+	for _, tc := range invalid {
+		field, record := tc.field, tc.record
+
+		err := record.Validate()
+		if err == nil {
+			t.Errorf("[%s] record '%s' should be invalid with `%s`", record.GetType(), record.GetName(), field)
+			continue
+		}
+
+		var validationErr *ValidationError
+		if !errors.As(err, &validationErr) || validationErr.Field != field {
+			t.Errorf("expected an error for `%s`, but got: %s", field, err)
+		}
+	}
+}
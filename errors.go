@@ -0,0 +1,172 @@
+package cfgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// error codes of Cloudflare API
+const (
+	errCodeInvalidRoute          = 7003
+	errCodeRateLimited           = 971
+	errCodeAuthenticationError   = 10000
+	errCodeUnknownAuthKey        = 9103
+	errCodeInvalidAuthHeaders    = 9106
+	errCodeInvalidAccessToken    = 9109
+	errCodeRecordNotFound        = 81044
+	errCodeRecordAlreadyExists   = 81057
+	errCodeRecordIdenticalExists = 81058
+)
+
+// APIError struct for errors returned from Cloudflare API
+//
+// Can be retrieved from returned errors with `errors.As`.
+type APIError struct {
+	StatusCode int    // HTTP status code
+	Method     string // HTTP method of the request
+	Endpoint   string // API endpoint of the request
+	RayID      string // value of `cf-ray` header
+
+	Errors   []ResponseMessage
+	Messages []ResponseMessage
+}
+
+// newAPIError creates an `*APIError` with given request values and response.
+func newAPIError(method, endpoint string, resp *http.Response, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     method,
+		Endpoint:   endpoint,
+		RayID:      resp.Header.Get(kCFRay),
+	}
+
+	var common ResponseCommon
+	if err := json.Unmarshal(body, &common); err == nil {
+		e.Errors = common.Errors
+		e.Messages = common.Messages
+	}
+
+	return e
+}
+
+// Error returns the string representation of this error.
+func (e *APIError) Error() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s %s: http status %d", e.Method, e.Endpoint, e.StatusCode)
+	if e.RayID != "" {
+		fmt.Fprintf(&sb, " (cf-ray: %s)", e.RayID)
+	}
+	if len(e.Errors) > 0 {
+		errs := []string{}
+		for _, err := range e.Errors {
+			errs = append(errs, fmt.Sprintf("[%d] %s", err.Code, err.Message))
+		}
+		fmt.Fprintf(&sb, ": %s", strings.Join(errs, ", "))
+	}
+
+	return sb.String()
+}
+
+// HasErrorCode returns whether this error contains any of given Cloudflare error codes.
+func (e *APIError) HasErrorCode(codes ...int) bool {
+	for _, err := range e.Errors {
+		if slices.Contains(codes, err.Code) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// returns an `*APIError` from given error, or nil if it is not an `*APIError`
+func asAPIError(err error) *APIError {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	return nil
+}
+
+// IsNotFound returns whether given error is an `*APIError` for a resource which does not exist,
+// or `ErrZoneNotFound` or `ErrRecordNotFound`.
+func IsNotFound(err error) bool {
+	if errors.Is(err, ErrZoneNotFound) || errors.Is(err, ErrRecordNotFound) {
+		return true
+	}
+
+	if e := asAPIError(err); e != nil {
+		return e.StatusCode == http.StatusNotFound ||
+			e.HasErrorCode(errCodeRecordNotFound, errCodeInvalidRoute)
+	}
+
+	return false
+}
+
+// IsRateLimited returns whether given error is an `*APIError` for exceeding the rate limit.
+func IsRateLimited(err error) bool {
+	if e := asAPIError(err); e != nil {
+		return e.StatusCode == http.StatusTooManyRequests ||
+			e.HasErrorCode(errCodeRateLimited)
+	}
+
+	return false
+}
+
+// IsAuthError returns whether given error is an `*APIError` for failed authentication or authorization.
+func IsAuthError(err error) bool {
+	if e := asAPIError(err); e != nil {
+		return e.StatusCode == http.StatusUnauthorized ||
+			e.StatusCode == http.StatusForbidden ||
+			e.HasErrorCode(errCodeAuthenticationError, errCodeUnknownAuthKey, errCodeInvalidAuthHeaders, errCodeInvalidAccessToken)
+	}
+
+	return false
+}
+
+// IsRecordAlreadyExists returns whether given error is an `*APIError` for a DNS record which already exists.
+func IsRecordAlreadyExists(err error) bool {
+	if e := asAPIError(err); e != nil {
+		return e.HasErrorCode(errCodeRecordAlreadyExists, errCodeRecordIdenticalExists)
+	}
+
+	return false
+}
+
+// AmbiguousRecordsError struct for errors when multiple DNS records match given name and type,
+// and none of them can be chosen by content.
+//
+// Can be retrieved from returned errors with `errors.As`.
+type AmbiguousRecordsError struct {
+	Name    string
+	Type    DNSRecordType
+	Content string // content used for choosing one of the records (can be empty)
+
+	Records []DNSRecordRaw // all records with the name and type
+}
+
+// Error returns the string representation of this error.
+func (e *AmbiguousRecordsError) Error() string {
+	records := []string{}
+	for _, record := range e.Records {
+		records = append(records, fmt.Sprintf("%s (%s)", record.GetID(), record.GetContent()))
+	}
+
+	if e.Content == "" {
+		return fmt.Sprintf("%d [%s] records named '%s' exist, content is needed for choosing one: %s",
+			len(e.Records), e.Type, e.Name, strings.Join(records, ", "))
+	}
+	return fmt.Sprintf("%d [%s] records named '%s' exist, but none of them has content '%s': %s",
+		len(e.Records), e.Type, e.Name, e.Content, strings.Join(records, ", "))
+}
+
+// IsAmbiguous returns whether given error is an `*AmbiguousRecordsError`.
+func IsAmbiguous(err error) bool {
+	var e *AmbiguousRecordsError
+	return errors.As(err, &e)
+}
@@ -0,0 +1,40 @@
+package cfgo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Header:     http.Header{kCFRay: []string{"0123456789abcdef-ICN"}},
+	}
+	body := []byte(`{"success":false,"errors":[{"code":81057,"message":"Record already exists."}],"messages":[]}`)
+
+	err := fmt.Errorf("wrapped: %w", newAPIError(http.MethodPost, "zones/abcd/dns_records", resp, body))
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("failed to retrieve *APIError from: %s", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest || apiErr.RayID != "0123456789abcdef-ICN" || len(apiErr.Errors) != 1 {
+		t.Errorf("unexpected values in *APIError: %+v", apiErr)
+	}
+	if !IsRecordAlreadyExists(err) {
+		t.Errorf("should be an error for an already-existing record: %s", err)
+	}
+	if IsNotFound(err) || IsRateLimited(err) || IsAuthError(err) {
+		t.Errorf("should not be an error for not found, rate limit, or authentication: %s", err)
+	}
+
+	resp.StatusCode = http.StatusTooManyRequests
+	if !IsRateLimited(newAPIError(http.MethodGet, "zones", resp, nil)) {
+		t.Errorf("should be an error for rate limit")
+	}
+	if IsRateLimited(errors.New("not an api error")) {
+		t.Errorf("should not be an error for rate limit")
+	}
+}
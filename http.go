@@ -2,147 +2,304 @@ package cfgo
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"maps"
+	"mime/multipart"
 	"net/http"
-	"net/http/httputil"
+	"net/url"
+	"reflect"
+	"slices"
+	"time"
 )
 
 const (
-	baseURL = "https://api.cloudflare.com/client/v4"
+	defaultBaseURL = "https://api.cloudflare.com/client/v4"
 
-	kContentType = "Content-Type"
-	kAuthKey     = "X-Auth-Key"
-	kAuthEmail   = "X-Auth-Email"
+	kContentType        = "Content-Type"
+	kAuthKey            = "X-Auth-Key"
+	kAuthEmail          = "X-Auth-Email"
+	kAuthorization      = "Authorization"
+	kAuthUserServiceKey = "X-Auth-User-Service-Key"
+	kCFRay              = "Cf-Ray"
+	kUserAgent          = "User-Agent"
 
 	defaultContentType = "application/json"
 )
 
 // do a request with query string
-func (c *CloudflareClient) _query(method, endpoint string, params map[string]any) (response []byte, err error) {
+func (c *CloudflareClient) _query(ctx context.Context, method, endpoint string, params map[string]any) (response []byte, err error) {
+	var req *http.Request
+	if req, err = c.newQueryRequest(ctx, method, endpoint, params); err != nil {
+		return nil, err
+	}
+
+	return c.send(req, endpoint)
+}
+
+// do a request with query string, and return the (non-JSON) response body as a stream
+//
+// The returned body should be closed by the caller.
+func (c *CloudflareClient) _stream(ctx context.Context, method, endpoint string, params map[string]any) (body io.ReadCloser, err error) {
+	var req *http.Request
+	if req, err = c.newQueryRequest(ctx, method, endpoint, params); err != nil {
+		return nil, err
+	}
+
+	return c.sendForStream(req, endpoint)
+}
+
+// create a request with query string
+func (c *CloudflareClient) newQueryRequest(ctx context.Context, method, endpoint string, params map[string]any) (req *http.Request, err error) {
 	if params == nil {
 		params = map[string]any{}
 	}
 
-	apiURL := fmt.Sprintf("%s/%s", baseURL, endpoint)
+	apiURL := fmt.Sprintf("%s/%s", c.baseURL, endpoint)
 
-	var req *http.Request
-	if req, err = http.NewRequest(method, apiURL, nil); err == nil {
-		// parameters
-		queries := req.URL.Query()
-		for k, v := range params {
-			queries.Add(k, fmt.Sprintf("%+v", v))
-		}
-		req.URL.RawQuery = queries.Encode()
+	if req, err = http.NewRequestWithContext(ctx, method, apiURL, nil); err != nil {
+		return nil, fmt.Errorf("failed to create request: %s", err)
+	}
 
-		// authentication headers
-		req.Header.Set(kAuthEmail, c.Email)
-		req.Header.Set(kAuthKey, c.APIKey)
-		req.Header.Set(kContentType, defaultContentType) // set content-type header
+	// parameters
+	req.URL.RawQuery = encodeQueries(params).Encode()
 
-		if c.Verbose {
-			if dumped, err := httputil.DumpRequest(req, true); err == nil {
-				log.Printf("dump request:\n\n%s", string(dumped))
-			}
-		}
+	c.setHeaders(req, defaultContentType)
+
+	return req, nil
+}
 
-		req.Close = true
+// set default, authentication, and content-type headers on given request
+func (c *CloudflareClient) setHeaders(req *http.Request, contentType string) {
+	// default headers
+	for key, values := range c.headers {
+		req.Header[key] = append([]string{}, values...)
+	}
 
-		// send request and return response bytes
-		var resp *http.Response
-		resp, err = c.httpClient.Do(req)
-		if resp != nil {
-			defer resp.Body.Close()
-		}
-		if err == nil {
-			if response, err = io.ReadAll(resp.Body); err == nil {
-				if c.Verbose {
-					log.Printf("API response for %s: '%s'", endpoint, string(response))
-				}
+	// authentication headers
+	if c.Email != "" || c.APIKey != "" { // deprecated fields take precedence, for backward compatibility
+		APIKeyAuthenticator{Email: c.Email, APIKey: c.APIKey}.Authenticate(req)
+	} else if c.authenticator != nil {
+		c.authenticator.Authenticate(req)
+	}
 
-				if resp.StatusCode != 200 {
-					err = fmt.Errorf("http status %d", resp.StatusCode)
-				}
+	req.Header.Set(kContentType, contentType) // set content-type header
+}
 
-				return response, err
+// encode given parameters into query values
+//
+// Slice values are encoded as repeated parameters.
+func encodeQueries(params map[string]any) url.Values {
+	values := url.Values{}
+	for k, v := range params {
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice {
+			for i := range rv.Len() {
+				values.Add(k, fmt.Sprintf("%+v", rv.Index(i).Interface()))
 			}
+		} else {
+			values.Add(k, fmt.Sprintf("%+v", v))
 		}
 	}
 
-	return nil, err
+	return values
 }
 
 // sends a HTTP GET request
-func (c *CloudflareClient) get(endpoint string, params map[string]any) (response []byte, err error) {
-	return c._query(http.MethodGet, endpoint, params)
+func (c *CloudflareClient) get(ctx context.Context, endpoint string, params map[string]any) (response []byte, err error) {
+	return c._query(ctx, http.MethodGet, endpoint, params)
+}
+
+// sends a HTTP GET request, and returns the (non-JSON) response body as a stream
+func (c *CloudflareClient) getStream(ctx context.Context, endpoint string, params map[string]any) (body io.ReadCloser, err error) {
+	return c._stream(ctx, http.MethodGet, endpoint, params)
 }
 
 // sends a HTTP DELETE request
-func (c *CloudflareClient) delete(endpoint string, params map[string]any) (response []byte, err error) {
-	return c._query(http.MethodDelete, endpoint, params)
+func (c *CloudflareClient) delete(ctx context.Context, endpoint string, params map[string]any) (response []byte, err error) {
+	return c._query(ctx, http.MethodDelete, endpoint, params)
 }
 
 // do a request with JSON body
-func (c *CloudflareClient) _json(method, endpoint string, params any) (response []byte, err error) {
+func (c *CloudflareClient) _json(ctx context.Context, method, endpoint string, params any) (response []byte, err error) {
 	if params == nil {
 		params = struct{}{}
 	}
 
-	apiURL := fmt.Sprintf("%s/%s", baseURL, endpoint)
-
-	var req *http.Request
+	apiURL := fmt.Sprintf("%s/%s", c.baseURL, endpoint)
 
 	// application/json
 	var serialized []byte
-	if serialized, err = json.Marshal(params); err == nil {
-		if req, err = http.NewRequest(method, apiURL, bytes.NewBuffer(serialized)); err != nil {
-			return nil, fmt.Errorf("failed to create application/json request: %s", err)
-		}
+	if serialized, err = json.Marshal(params); err != nil {
+		return nil, fmt.Errorf("failed to serialize params: %s", err)
+	}
 
-		// authentication headers
-		req.Header.Set(kAuthEmail, c.Email)
-		req.Header.Set(kAuthKey, c.APIKey)
-		req.Header.Set(kContentType, defaultContentType) // set content-type header
+	var req *http.Request
+	if req, err = http.NewRequestWithContext(ctx, method, apiURL, bytes.NewBuffer(serialized)); err != nil {
+		return nil, fmt.Errorf("failed to create application/json request: %s", err)
 	}
 
-	if c.Verbose {
-		if dumped, err := httputil.DumpRequest(req, true); err == nil {
-			log.Printf("dump request:\n\n%s", string(dumped))
+	c.setHeaders(req, defaultContentType)
+
+	return c.send(req, endpoint)
+}
+
+// sends a HTTP POST request
+func (c *CloudflareClient) post(ctx context.Context, endpoint string, params any) (response []byte, err error) {
+	return c._json(ctx, http.MethodPost, endpoint, params)
+}
+
+// sends a HTTP PUT request
+func (c *CloudflareClient) put(ctx context.Context, endpoint string, params any) (response []byte, err error) {
+	return c._json(ctx, http.MethodPut, endpoint, params)
+}
+
+// sends a HTTP PATCH request
+func (c *CloudflareClient) patch(ctx context.Context, endpoint string, params any) (response []byte, err error) {
+	return c._json(ctx, http.MethodPatch, endpoint, params)
+}
+
+// file part of multipart requests
+type multipartFile struct {
+	filename string
+	reader   io.Reader
+}
+
+// do a request with multipart/form-data body
+func (c *CloudflareClient) _multipart(ctx context.Context, method, endpoint string, fields map[string]string, files map[string]multipartFile) (response []byte, err error) {
+	apiURL := fmt.Sprintf("%s/%s", c.baseURL, endpoint)
+
+	// multipart/form-data
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		if err = writer.WriteField(name, fields[name]); err != nil {
+			return nil, fmt.Errorf("failed to write multipart field '%s': %s", name, err)
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		var part io.Writer
+		if part, err = writer.CreateFormFile(name, files[name].filename); err == nil {
+			_, err = io.Copy(part, files[name].reader)
 		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to write multipart file '%s': %s", name, err)
+		}
+	}
+	if err = writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close multipart writer: %s", err)
 	}
-	req.Close = true
 
-	// send request and return response bytes
+	var req *http.Request
+	if req, err = http.NewRequestWithContext(ctx, method, apiURL, bytes.NewReader(body.Bytes())); err != nil {
+		return nil, fmt.Errorf("failed to create multipart/form-data request: %s", err)
+	}
+
+	c.setHeaders(req, writer.FormDataContentType())
+
+	return c.send(req, endpoint)
+}
+
+// sends a HTTP POST request with multipart/form-data body
+func (c *CloudflareClient) postMultipart(ctx context.Context, endpoint string, fields map[string]string, files map[string]multipartFile) (response []byte, err error) {
+	return c._multipart(ctx, http.MethodPost, endpoint, fields, files)
+}
+
+// send given request and return response bytes, pacing with the rate limiter and retrying with the retry policy (if any)
+//
+// When the response has a non-2xx status code, the response bytes are returned along with an `*APIError`.
+func (c *CloudflareClient) send(req *http.Request, endpoint string) (response []byte, err error) {
+	response, _, err = c.do(req, endpoint, false)
+
+	return response, err
+}
+
+// send given request like `send`, but return the body of a successful response as a stream without reading it
+//
+// The returned body should be closed by the caller.
+func (c *CloudflareClient) sendForStream(req *http.Request, endpoint string) (body io.ReadCloser, err error) {
 	var resp *http.Response
-	resp, err = c.httpClient.Do(req)
-	if resp != nil {
-		defer resp.Body.Close()
+	if _, resp, err = c.do(req, endpoint, true); err != nil {
+		return nil, err
 	}
-	if err == nil {
-		if response, err = io.ReadAll(resp.Body); err == nil {
-			if c.Verbose {
-				log.Printf("API response for %s: '%s'", endpoint, string(response))
-			}
 
-			if resp.StatusCode != 200 {
-				err = fmt.Errorf("http status %d", resp.StatusCode)
+	return resp.Body, nil
+}
+
+// send given request with the rate limiter and the retry policy (if any)
+//
+// When `stream` is true, the body of a successful (2xx) response is left unread (and open) in the returned response.
+func (c *CloudflareClient) do(req *http.Request, endpoint string, stream bool) (response []byte, resp *http.Response, err error) {
+	maxAttempts := c.RetryPolicy.maxAttempts()
+
+	for attempt := 1; ; attempt++ {
+		if c.RateLimiter != nil {
+			if err := c.RateLimiter.Wait(req.Context()); err != nil {
+				return nil, nil, err
 			}
+		}
+
+		response, resp, err = c.sendOnce(req, endpoint, attempt, stream)
+
+		if attempt >= maxAttempts || !c.RetryPolicy.shouldRetry(req.Method, resp, err) {
+			break
+		}
 
-			return response, err
+		backoff := c.RetryPolicy.backoff(attempt, resp)
+		c.logRetry(req.Context(), endpoint, attempt+1, maxAttempts, backoff)
+		if err := sleep(req.Context(), backoff); err != nil {
+			return nil, nil, err
 		}
+
+		// rewind the request body for the next attempt
+		next := req.Clone(req.Context())
+		if req.GetBody != nil {
+			if next.Body, err = req.GetBody(); err != nil {
+				return nil, nil, fmt.Errorf("failed to rewind request body: %s", err)
+			}
+		}
+		req = next
+	}
+
+	if err == nil && !isSuccessful(resp) {
+		err = newAPIError(req.Method, endpoint, resp, response)
 	}
 
-	return nil, err
+	return response, resp, err
 }
 
-// sends a HTTP POST request
-func (c *CloudflareClient) post(endpoint string, params any) (response []byte, err error) {
-	return c._json(http.MethodPost, endpoint, params)
+// send given request once and return response bytes with the response
+//
+// When `stream` is true, the body of a successful (2xx) response is not read.
+func (c *CloudflareClient) sendOnce(req *http.Request, endpoint string, attempt int, stream bool) (response []byte, resp *http.Response, err error) {
+	c.logRequest(req, endpoint, attempt)
+
+	start := time.Now()
+	defer func() {
+		c.logResponse(req, endpoint, resp, response, time.Since(start), err)
+	}()
+
+	resp, err = c.httpClient.Do(req)
+	if err != nil {
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		return nil, nil, err
+	}
+	if stream && isSuccessful(resp) {
+		return nil, resp, nil // body will be read (and closed) by the caller
+	}
+	defer resp.Body.Close()
+
+	if response, err = io.ReadAll(resp.Body); err != nil {
+		return nil, nil, err
+	}
+
+	return response, resp, nil
 }
 
-// sends a HTTP PUT request
-func (c *CloudflareClient) put(endpoint string, params any) (response []byte, err error) {
-	return c._json(http.MethodPut, endpoint, params)
+// returns whether given response has a 2xx status code
+func isSuccessful(resp *http.Response) bool {
+	return resp.StatusCode >= 200 && resp.StatusCode < 300
 }
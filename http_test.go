@@ -0,0 +1,50 @@
+package cfgo
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// benchmark requests to a TLS server, with or without reusing connections
+func benchmarkRequests(b *testing.B, reuseConnections bool) {
+	var conns atomic.Int64
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":[]}`))
+	}))
+	server.EnableHTTP2 = true
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	transport := newTransport()
+	transport.TLSClientConfig = server.Client().Transport.(*http.Transport).TLSClientConfig.Clone()
+	transport.DisableKeepAlives = !reuseConnections
+
+	client := NewCloudflareClientWithAPIToken("test-token",
+		WithBaseURL(server.URL),
+		WithTransport(transport),
+	)
+
+	for b.Loop() {
+		if _, err := client.ListZones(); err != nil {
+			b.Fatalf("failed to list zones: %s", err)
+		}
+	}
+	b.ReportMetric(float64(conns.Load())/float64(b.N), "conns/op")
+}
+
+func BenchmarkRequestsWithConnectionReuse(b *testing.B) {
+	benchmarkRequests(b, true)
+}
+
+func BenchmarkRequestsWithoutConnectionReuse(b *testing.B) {
+	benchmarkRequests(b, false)
+}
@@ -0,0 +1,194 @@
+package cfgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	redacted = "[REDACTED]"
+)
+
+// headers which carry credentials
+var sensitiveHeaders = []string{
+	kAuthorization,
+	kAuthKey,
+	kAuthEmail,
+	kAuthUserServiceKey,
+}
+
+// names of fields which carry secrets or personal information in request/response bodies (compared case-insensitively)
+var sensitiveFieldNames = []string{
+	"access_token",
+	"api_key",
+	"api_token",
+	"client_secret",
+	"email",
+	"owner_email",
+	"password",
+	"private_key",
+	"refresh_token",
+	"secret",
+	"service_key",
+	"token",
+	"user_service_key",
+}
+
+// logger for `Verbose` mode, used when no `Logger` is set
+var verboseLogger = sync.OnceValue(func() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	}))
+})
+
+// returns the logger of this client, or nil if logging is disabled
+func (c *CloudflareClient) logger() *slog.Logger {
+	if c.Logger != nil {
+		return c.Logger
+	}
+	if c.Verbose {
+		return verboseLogger()
+	}
+
+	return nil
+}
+
+// logs a request event with credentials redacted
+func (c *CloudflareClient) logRequest(req *http.Request, endpoint string, attempt int) {
+	logger := c.logger()
+	if logger == nil || !logger.Enabled(req.Context(), slog.LevelDebug) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("endpoint", endpoint),
+		slog.Int("attempt", attempt),
+		slog.Any("headers", redactHeaders(req.Header)),
+	}
+	if req.URL.RawQuery != "" {
+		attrs = append(attrs, slog.String("query", req.URL.RawQuery))
+	}
+	if isMultipart(req.Header) {
+		// uploaded files are not logged, as they can be large or carry secrets
+		attrs = append(attrs, slog.String("body", fmt.Sprintf("[multipart/form-data: %d bytes]", req.ContentLength)))
+	} else if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			if bytes, err := io.ReadAll(body); err == nil && len(bytes) > 0 {
+				attrs = append(attrs, slog.String("body", string(redactBody(bytes))))
+			}
+			_ = body.Close()
+		}
+	}
+
+	logger.LogAttrs(req.Context(), slog.LevelDebug, "cloudflare api request", attrs...)
+}
+
+// logs a response event with secrets redacted
+func (c *CloudflareClient) logResponse(req *http.Request, endpoint string, resp *http.Response, body []byte, duration time.Duration, err error) {
+	logger := c.logger()
+	if logger == nil {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("endpoint", endpoint),
+		slog.Duration("duration", duration),
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		logger.LogAttrs(req.Context(), slog.LevelDebug, "cloudflare api request failed", attrs...)
+		return
+	}
+
+	attrs = append(attrs,
+		slog.Int("status", resp.StatusCode),
+		slog.String("cf_ray", resp.Header.Get(kCFRay)),
+		slog.String("body", string(redactBody(body))),
+	)
+	logger.LogAttrs(req.Context(), slog.LevelDebug, "cloudflare api response", attrs...)
+}
+
+// logs a retry event
+func (c *CloudflareClient) logRetry(ctx context.Context, endpoint string, attempt, maxAttempts int, backoff time.Duration) {
+	if logger := c.logger(); logger != nil {
+		logger.LogAttrs(ctx, slog.LevelDebug, "retrying cloudflare api request",
+			slog.String("endpoint", endpoint),
+			slog.Int("attempt", attempt),
+			slog.Int("max_attempts", maxAttempts),
+			slog.Duration("backoff", backoff),
+		)
+	}
+}
+
+// checks if given headers are of a multipart request
+func isMultipart(headers http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(headers.Get(kContentType))
+
+	return err == nil && strings.HasPrefix(mediaType, "multipart/")
+}
+
+// returns a copy of given headers with credentials redacted
+func redactHeaders(headers http.Header) http.Header {
+	redactedHeaders := headers.Clone()
+	for _, header := range sensitiveHeaders {
+		if redactedHeaders.Get(header) != "" {
+			redactedHeaders.Set(header, redacted)
+		}
+	}
+
+	return redactedHeaders
+}
+
+// checks if given field name carries secrets or personal information
+func isSensitiveField(name string) bool {
+	return slices.Contains(sensitiveFieldNames, strings.ToLower(name))
+}
+
+// returns a copy of given (JSON) body with secret-bearing fields redacted by their exact names
+//
+// Non-JSON bodies are returned as they are.
+func redactBody(body []byte) []byte {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+
+	if redactedBody, err := json.Marshal(redactValue(v)); err == nil {
+		return redactedBody
+	}
+
+	return body
+}
+
+// redacts values of secret-bearing fields recursively
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if isSensitiveField(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(value)
+			}
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,103 @@
+package cfgo
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLoggingRedaction(t *testing.T) {
+	const (
+		email  = "secret-user@example.com"
+		apiKey = "secret-api-key-0123456789"
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(kCFRay, "0123456789abcdef-ICN")
+		_, _ = w.Write([]byte(`{"success":true,"result":{"id":"record-id","token":"secret-token-in-response"}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := NewCloudflareClient(email, apiKey,
+		WithBaseURL(server.URL),
+		WithLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))))
+
+	if _, err := client.CreateDNSRecord("zone-id", DNSRecordRaw{
+		"type":    "TXT",
+		"name":    "test.example.com",
+		"content": "hello",
+		"api_key": "secret-api-key-in-body",
+	}); err != nil {
+		t.Fatalf("failed to create dns record: %s", err)
+	}
+
+	logged := buf.String()
+	for _, secret := range []string{email, apiKey, "secret-api-key-in-body", "secret-token-in-response"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("secret '%s' was not redacted in logs: %s", secret, logged)
+		}
+	}
+	for _, expected := range []string{redacted, `"status":200`, `"cf_ray":"0123456789abcdef-ICN"`, `"endpoint":"zones/zone-id/dns_records"`, `"duration":`} {
+		if !strings.Contains(logged, expected) {
+			t.Errorf("'%s' was not found in logs: %s", expected, logged)
+		}
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	redactedBody := string(redactBody([]byte(`{
+	"result": {
+		"name": "example.com",
+		"owner": {"id": "owner-id", "email": "owner@example.com", "type": "user"},
+		"Email": "upper@example.com",
+		"token_count": 3,
+		"secrets_manager": "visible",
+		"records": [{"api_token": "secret-api-token", "comment": "token rotation"}]
+	}
+}`)))
+
+	for _, secret := range []string{"owner@example.com", "upper@example.com", "secret-api-token"} {
+		if strings.Contains(redactedBody, secret) {
+			t.Errorf("'%s' was not redacted: %s", secret, redactedBody)
+		}
+	}
+	for _, expected := range []string{`"token_count":3`, `"secrets_manager":"visible"`, `"comment":"token rotation"`, `"id":"owner-id"`} {
+		if !strings.Contains(redactedBody, expected) {
+			t.Errorf("'%s' should not be redacted: %s", expected, redactedBody)
+		}
+	}
+
+	if body := "not a json"; string(redactBody([]byte(body))) != body {
+		t.Errorf("non-JSON body should be returned as it is")
+	}
+}
+
+func TestLoggingMultipart(t *testing.T) {
+	const secretInFile = "secret-content-of-uploaded-file"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"success":true,"result":{"recs_added":1,"total_records_parsed":1}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	client := NewCloudflareClientWithAPIToken("test-token",
+		WithBaseURL(server.URL),
+		WithLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))))
+
+	if _, err := client.ImportDNSRecords("zone-id", strings.NewReader(`txt.example.com. IN TXT "`+secretInFile+`"`), nil); err != nil {
+		t.Fatalf("failed to import dns records: %s", err)
+	}
+
+	logged := buf.String()
+	if strings.Contains(logged, secretInFile) {
+		t.Errorf("uploaded file was logged: %s", logged)
+	}
+	if !strings.Contains(logged, `[multipart/form-data: `) {
+		t.Errorf("multipart body was not summarized in logs: %s", logged)
+	}
+}
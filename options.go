@@ -0,0 +1,85 @@
+package cfgo
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Option for configuring a CloudflareClient
+type Option func(c *CloudflareClient)
+
+// WithBaseURL sets the base URL of API requests. (eg. for a local stand-in or an egress proxy)
+func WithBaseURL(baseURL string) Option {
+	return func(c *CloudflareClient) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithHTTPClient sets the HTTP client for sending API requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *CloudflareClient) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTransport sets the transport of the HTTP client. (eg. for mTLS or tracing)
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *CloudflareClient) {
+		copied := *c.httpClient
+		copied.Transport = transport
+		c.httpClient = &copied
+	}
+}
+
+// WithTimeout sets the timeout of each HTTP request, including reading of the response body.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *CloudflareClient) {
+		copied := *c.httpClient
+		copied.Timeout = timeout
+		c.httpClient = &copied
+	}
+}
+
+// WithUserAgent sets the `User-Agent` header of API requests.
+func WithUserAgent(userAgent string) Option {
+	return WithHeader(kUserAgent, userAgent)
+}
+
+// WithHeader sets a default header of API requests.
+func WithHeader(key, value string) Option {
+	return func(c *CloudflareClient) {
+		c.headers.Set(key, value)
+	}
+}
+
+// WithHeaders sets default headers of API requests.
+func WithHeaders(headers http.Header) Option {
+	return func(c *CloudflareClient) {
+		for key, values := range headers {
+			c.headers[http.CanonicalHeaderKey(key)] = append([]string{}, values...)
+		}
+	}
+}
+
+// WithRetryPolicy sets the retry policy for failed requests.
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *CloudflareClient) {
+		c.RetryPolicy = policy
+	}
+}
+
+// WithRateLimiter sets the rate limiter for pacing requests.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *CloudflareClient) {
+		c.RateLimiter = limiter
+	}
+}
+
+// WithLogger sets the logger for request/response events.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *CloudflareClient) {
+		c.Logger = logger
+	}
+}
@@ -0,0 +1,52 @@
+package cfgo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestOptions(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		_, _ = w.Write([]byte(`{"success":true,"result":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewCloudflareClientWithAPIToken("test-token",
+		WithBaseURL(server.URL+"/"),
+		WithTimeout(3*time.Second),
+		WithUserAgent("cloudflare-go-test/1.0"),
+		WithHeader("X-Custom-Header", "custom value"),
+		WithRetryPolicy(DefaultRetryPolicy()),
+	)
+
+	if client.httpClient.Timeout != 3*time.Second {
+		t.Errorf("timeout was not applied: %s", client.httpClient.Timeout)
+	}
+	if client.RetryPolicy == nil {
+		t.Errorf("retry policy was not applied")
+	}
+
+	if _, err := client.ListZones(); err != nil {
+		t.Fatalf("failed to list zones: %s", err)
+	}
+	for key, expected := range map[string]string{
+		kUserAgent:        "cloudflare-go-test/1.0",
+		"X-Custom-Header": "custom value",
+		kAuthorization:    "Bearer test-token",
+	} {
+		if value := received.Get(key); value != expected {
+			t.Errorf("expected header '%s' to be '%s', but was '%s'", key, expected, value)
+		}
+	}
+
+	// custom http client
+	httpClient := &http.Client{}
+	client = NewCloudflareClientWithAPIToken("test-token", WithHTTPClient(httpClient), WithTransport(http.DefaultTransport))
+	if client.httpClient.Transport != http.DefaultTransport || httpClient.Transport != nil {
+		t.Errorf("transport should be applied to a copy of given http client")
+	}
+}
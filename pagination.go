@@ -0,0 +1,122 @@
+package cfgo
+
+import (
+	"context"
+	"iter"
+	"maps"
+)
+
+const (
+	defaultZonesPerPage      = 50
+	defaultDNSRecordsPerPage = 100
+)
+
+// fetches a page of items with given page number (starting from 1) and page size
+type pageFetcher[T any] func(ctx context.Context, page, perPage int) (items []T, info ResultInfo, err error)
+
+// generic function for iterating over items of all pages
+//
+// Iteration stops on the first error, which is yielded with a zero value.
+func paginate[T any](ctx context.Context, perPage int, fetch pageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		fetched := 0
+		for page := 1; ; page++ {
+			items, info, err := fetch(ctx, page, perPage)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			fetched += len(items)
+
+			// check if it was the last page
+			if len(items) == 0 ||
+				(info.TotalPages > 0 && page >= info.TotalPages) ||
+				(info.TotalCount > 0 && fetched >= info.TotalCount) ||
+				(info.TotalPages == 0 && info.TotalCount == 0 && len(items) < perPage) {
+				return
+			}
+		}
+	}
+}
+
+// generic function for collecting items of all pages
+func collect[T any](seq iter.Seq2[T, error]) (all []T, err error) {
+	all = []T{}
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, item)
+	}
+
+	return all, nil
+}
+
+// returns a copy of given queries with pagination parameters
+func withPage(queries map[string]any, page, perPage int) map[string]any {
+	copied := maps.Clone(queries)
+	if copied == nil {
+		copied = map[string]any{}
+	}
+	copied["page"] = page
+	copied["per_page"] = perPage
+
+	return copied
+}
+
+// IterateZones returns an iterator over all zones (optionally with filters), fetching `perPage` zones per request.
+//
+// Default page size will be used when `perPage` <= 0.
+func (c *CloudflareClient) IterateZones(ctx context.Context, perPage int, filters ...*ZoneFilter) iter.Seq2[Zone, error] {
+	if perPage <= 0 {
+		perPage = defaultZonesPerPage
+	}
+	queries := zoneFilterQueries(filters)
+
+	return paginate(ctx, perPage, func(ctx context.Context, page, perPage int) ([]Zone, ResultInfo, error) {
+		response, err := c.listZones(ctx, withPage(queries, page, perPage))
+		return response.Result, response.ResultInfo, err
+	})
+}
+
+// ListAllZones returns all zones of all pages, optionally with filters.
+func (c *CloudflareClient) ListAllZones(filters ...*ZoneFilter) (zones []Zone, err error) {
+	return c.ListAllZonesContext(context.Background(), filters...)
+}
+
+// ListAllZonesContext returns all zones of all pages, optionally with filters, with given context.
+func (c *CloudflareClient) ListAllZonesContext(ctx context.Context, filters ...*ZoneFilter) (zones []Zone, err error) {
+	return collect(c.IterateZones(ctx, 0, filters...))
+}
+
+// IterateDNSRecords returns an iterator over all DNS records for given zone identifier and queries,
+// fetching `perPage` records per request.
+//
+// Default page size will be used when `perPage` <= 0.
+func (c *CloudflareClient) IterateDNSRecords(ctx context.Context, zoneID string, queries map[string]any, perPage int) iter.Seq2[DNSRecordRaw, error] {
+	if perPage <= 0 {
+		perPage = defaultDNSRecordsPerPage
+	}
+
+	return paginate(ctx, perPage, func(ctx context.Context, page, perPage int) ([]DNSRecordRaw, ResultInfo, error) {
+		response, err := c.ListDNSRecordsContext(ctx, zoneID, withPage(queries, page, perPage))
+		return response.Result, response.ResultInfo, err
+	})
+}
+
+// ListAllDNSRecords returns DNS records of all pages for given zone identifier and queries.
+func (c *CloudflareClient) ListAllDNSRecords(zoneID string, queries map[string]any) (records []DNSRecordRaw, err error) {
+	return c.ListAllDNSRecordsContext(context.Background(), zoneID, queries)
+}
+
+// ListAllDNSRecordsContext returns DNS records of all pages for given zone identifier and queries, with given context.
+func (c *CloudflareClient) ListAllDNSRecordsContext(ctx context.Context, zoneID string, queries map[string]any) (records []DNSRecordRaw, err error) {
+	return collect(c.IterateDNSRecords(ctx, zoneID, queries, 0))
+}
@@ -0,0 +1,70 @@
+package cfgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// returns a test server which serves `total` DNS records in pages
+func newPagingServer(total int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+
+		records := []map[string]any{}
+		for i := (page - 1) * perPage; i < min(page*perPage, total); i++ {
+			records = append(records, map[string]any{
+				"id":   fmt.Sprintf("record-%d", i),
+				"type": "A",
+			})
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"result":  records,
+			"result_info": map[string]any{
+				"page":        page,
+				"per_page":    perPage,
+				"count":       len(records),
+				"total_count": total,
+				"total_pages": (total + perPage - 1) / perPage,
+			},
+		})
+	}))
+}
+
+func TestIterateDNSRecords(t *testing.T) {
+	server := newPagingServer(250)
+	defer server.Close()
+
+	client := NewCloudflareClientWithAPIToken("test-token", WithBaseURL(server.URL))
+
+	// all pages
+	if records, err := client.ListAllDNSRecords("zone-id", nil); err != nil {
+		t.Errorf("failed to list all dns records: %s", err)
+	} else if len(records) != 250 {
+		t.Errorf("expected 250 records, but got %d", len(records))
+	}
+
+	// early termination
+	count := 0
+	for record, err := range client.IterateDNSRecords(context.Background(), "zone-id", nil, 30) {
+		if err != nil {
+			t.Fatalf("failed to iterate dns records: %s", err)
+		}
+		if id, _ := record.StringFor("id"); id != fmt.Sprintf("record-%d", count) {
+			t.Errorf("unexpected record id: %s", id)
+		}
+		if count++; count >= 45 {
+			break
+		}
+	}
+	if count != 45 {
+		t.Errorf("expected 45 iterated records, but got %d", count)
+	}
+}
@@ -0,0 +1,132 @@
+package cfgo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Cloudflare's global rate limit (1200 requests per 5 minutes)
+//
+// https://developers.cloudflare.com/fundamentals/api/reference/limits/
+const (
+	defaultRateLimitRequests = 1200
+	defaultRateLimitWindow   = 5 * time.Minute
+	defaultRateLimitBurst    = 10
+)
+
+// RateLimiter is a token-bucket rate limiter for pacing requests, safe for concurrent use.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	rate  float64 // tokens per second
+	burst float64
+
+	tokens float64
+	last   time.Time
+
+	stats RateLimiterStats
+}
+
+// RateLimiterStats struct for the statistics of a rate limiter
+type RateLimiterStats struct {
+	Requests int64         // number of requests which passed the limiter
+	Waits    int64         // number of requests which had to wait
+	WaitTime time.Duration // total time spent waiting
+}
+
+// NewRateLimiter returns a new rate limiter which allows `requests` requests per `window`,
+// with bursts of up to `burst` requests.
+func NewRateLimiter(requests int, window time.Duration, burst int) *RateLimiter {
+	if requests < 1 {
+		requests = 1
+	}
+	if window <= 0 {
+		window = time.Second
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   float64(requests) / window.Seconds(),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// DefaultRateLimiter returns a new rate limiter for Cloudflare's global rate limit.
+func DefaultRateLimiter() *RateLimiter {
+	return NewRateLimiter(defaultRateLimitRequests, defaultRateLimitWindow, defaultRateLimitBurst)
+}
+
+// refill tokens for the elapsed time (should be called with the lock held)
+func (l *RateLimiter) refill(now time.Time) {
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+		l.last = now
+	}
+}
+
+// Wait takes a token, blocking until one is available or the context is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	l.refill(time.Now())
+	l.tokens -= 1 // reserve a token (can go negative, for queueing waiters)
+	wait := time.Duration(0)
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if wait > 0 {
+		if err := sleep(ctx, wait); err != nil {
+			// give back the reserved token
+			l.mu.Lock()
+			l.tokens = min(l.burst, l.tokens+1)
+			l.mu.Unlock()
+
+			return err
+		}
+	}
+
+	l.mu.Lock()
+	l.stats.Requests++
+	if wait > 0 {
+		l.stats.Waits++
+		l.stats.WaitTime += wait
+	}
+	l.mu.Unlock()
+
+	return nil
+}
+
+// Tokens returns the number of currently available tokens.
+//
+// It can be negative when there are requests waiting for tokens.
+func (l *RateLimiter) Tokens() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+
+	return l.tokens
+}
+
+// WaitTime returns the estimated time a new request would have to wait for a token.
+func (l *RateLimiter) WaitTime() time.Duration {
+	if tokens := l.Tokens(); tokens < 1 {
+		return time.Duration((1 - tokens) / l.rate * float64(time.Second))
+	}
+
+	return 0
+}
+
+// Stats returns the statistics of this rate limiter.
+func (l *RateLimiter) Stats() RateLimiterStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.stats
+}
@@ -0,0 +1,51 @@
+package cfgo
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	// 10 requests per 100ms (= 1 request per 10ms), with burst of 2
+	limiter := NewRateLimiter(10, 100*time.Millisecond, 2)
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Go(func() {
+			if err := limiter.Wait(context.Background()); err != nil {
+				t.Errorf("failed to wait for rate limiter: %s", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	// 2 requests pass immediately, and the other 4 requests wait for 10ms each
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Errorf("requests should have been paced, but took only %s", elapsed)
+	}
+
+	stats := limiter.Stats()
+	if stats.Requests != 6 || stats.Waits < 4 {
+		t.Errorf("unexpected stats of rate limiter: %+v", stats)
+	}
+	if tokens := limiter.Tokens(); tokens > 1 {
+		t.Errorf("tokens should have been consumed, but was %f", tokens)
+	}
+
+	// canceled while waiting
+	limiter = NewRateLimiter(1, time.Hour, 1)
+	_ = limiter.Wait(context.Background())
+	if limiter.WaitTime() <= 0 {
+		t.Errorf("should wait for a token")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); err == nil {
+		t.Errorf("should fail with canceled context")
+	}
+}
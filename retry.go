@@ -0,0 +1,146 @@
+package cfgo
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	kRetryAfter = "Retry-After"
+
+	defaultRetryMaxAttempts = 4
+	defaultRetryMinBackoff  = 1 * time.Second
+	defaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// RetryPolicy struct for retrying failed requests
+//
+// Requests are retried on network errors, HTTP 429 (Too Many Requests), and transient 5xx errors.
+type RetryPolicy struct {
+	MaxAttempts int           // maximum number of attempts, including the first one
+	MinBackoff  time.Duration // backoff before the first retry, doubled on each retry
+	MaxBackoff  time.Duration // upper bound of backoffs (also of the ones requested by `Retry-After` headers)
+
+	// retry non-idempotent requests (POST, PATCH) too
+	//
+	// NOTE: retrying them may result in duplicated resources
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a new retry policy with default values.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: defaultRetryMaxAttempts,
+		MinBackoff:  defaultRetryMinBackoff,
+		MaxBackoff:  defaultRetryMaxBackoff,
+	}
+}
+
+// returns the maximum number of attempts
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+// checks if a request with given method and result should be retried
+func (p *RetryPolicy) shouldRetry(method string, resp *http.Response, err error) bool {
+	if p == nil {
+		return false
+	}
+
+	if !p.RetryNonIdempotent && !isIdempotent(method) {
+		return false
+	}
+
+	if err != nil {
+		// do not retry canceled or timed-out requests
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// returns the backoff duration before the next attempt
+//
+// `attempt` starts from 1, and `Retry-After` header of given response (if any) takes precedence,
+// but no backoff exceeds `MaxBackoff`.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = defaultRetryMinBackoff
+	}
+	if maxBackoff < minBackoff {
+		maxBackoff = minBackoff
+	}
+
+	if resp != nil {
+		if after, ok := retryAfter(resp.Header.Get(kRetryAfter)); ok {
+			return min(after, maxBackoff)
+		}
+	}
+
+	backoff := minBackoff
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, maxBackoff)
+
+	// equal jitter: [backoff/2, backoff)
+	half := backoff / 2
+	return half + rand.N(backoff-half)
+}
+
+// parses the value of `Retry-After` header (in seconds or HTTP date)
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0), true
+	}
+
+	return 0, false
+}
+
+// checks if given HTTP method is idempotent
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// waits for given duration, or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
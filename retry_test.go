@@ -0,0 +1,102 @@
+package cfgo
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// returns a test server which fails with given status code for the first `failures` requests
+func newFlakyServer(failures int32, statusCode int) (server *httptest.Server, requests *atomic.Int32) {
+	requests = &atomic.Int32{}
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= failures {
+			w.Header().Set(kRetryAfter, "0")
+			w.WriteHeader(statusCode)
+			_, _ = w.Write([]byte(`{"success":false,"errors":[{"code":971,"message":"Please wait and consider throttling your request speed"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":[]}`))
+	}))
+
+	return server, requests
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy := &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+	}
+
+	// retried until success
+	server, requests := newFlakyServer(2, http.StatusTooManyRequests)
+	defer server.Close()
+
+	client := NewCloudflareClientWithAPIToken("test-token", WithBaseURL(server.URL))
+	client.RetryPolicy = policy
+
+	if _, err := client.ListZones(); err != nil {
+		t.Errorf("failed to list zones with retries: %s", err)
+	}
+	if requests.Load() != 3 {
+		t.Errorf("expected 3 requests, but was %d", requests.Load())
+	}
+
+	// gives up after max attempts
+	server, requests = newFlakyServer(5, http.StatusServiceUnavailable)
+	defer server.Close()
+	client.baseURL = server.URL
+
+	if _, err := client.ListZones(); err == nil {
+		t.Errorf("should fail after max attempts")
+	}
+	if requests.Load() != 3 {
+		t.Errorf("expected 3 requests, but was %d", requests.Load())
+	}
+
+	// non-idempotent requests are not retried by default
+	server, requests = newFlakyServer(1, http.StatusTooManyRequests)
+	defer server.Close()
+	client.baseURL = server.URL
+
+	if _, err := client.CreateDNSRecord("zone-id", NewDNSRecordA("test.com", "1.2.3.4")); !IsRateLimited(err) {
+		t.Errorf("should fail with rate limit error, but got: %v", err)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("expected 1 request, but was %d", requests.Load())
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := &RetryPolicy{
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: 300 * time.Millisecond,
+	}
+
+	for attempt, limit := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		5: 300 * time.Millisecond,
+	} {
+		if backoff := policy.backoff(attempt, nil); backoff < limit/2 || backoff >= limit {
+			t.Errorf("backoff for attempt %d should be in [%s, %s), but was %s", attempt, limit/2, limit, backoff)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{kRetryAfter: []string{"7"}}}
+	if backoff := policy.backoff(1, resp); backoff != 300*time.Millisecond {
+		t.Errorf("backoff for `Retry-After` header should be capped at %s, but was %s", policy.MaxBackoff, backoff)
+	}
+
+	policy.MaxBackoff = 10 * time.Second
+	if backoff := policy.backoff(1, resp); backoff != 7*time.Second {
+		t.Errorf("backoff should follow `Retry-After` header, but was %s", backoff)
+	}
+	resp.Header.Set(kRetryAfter, time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	if backoff := policy.backoff(1, resp); backoff != 10*time.Second {
+		t.Errorf("backoff for `Retry-After` date should be capped at %s, but was %s", policy.MaxBackoff, backoff)
+	}
+}
@@ -0,0 +1,150 @@
+package cfgo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ListZones returns zones (of the first page), optionally with filters.
+//
+// Use `ListAllZones` or `IterateZones` for retrieving zones of all pages.
+//
+// https://developers.cloudflare.com/api/operations/zones-get
+func (c *CloudflareClient) ListZones(filters ...*ZoneFilter) (response ResponseZones, err error) {
+	return c.ListZonesContext(context.Background(), filters...)
+}
+
+// ListZonesContext returns zones (of the first page), optionally with filters, with given context.
+func (c *CloudflareClient) ListZonesContext(ctx context.Context, filters ...*ZoneFilter) (response ResponseZones, err error) {
+	return c.listZones(ctx, zoneFilterQueries(filters))
+}
+
+// list zones with given queries
+func (c *CloudflareClient) listZones(ctx context.Context, queries map[string]any) (response ResponseZones, err error) {
+	var bytes []byte
+	bytes, err = c.get(ctx, "zones", queries)
+
+	if err == nil {
+		err = json.Unmarshal(bytes, &response)
+	}
+
+	return response, err
+}
+
+// GetZone returns a zone with given identifier.
+//
+// https://developers.cloudflare.com/api/operations/zones-0-get
+func (c *CloudflareClient) GetZone(zoneID string) (response ResponseZone, err error) {
+	return c.GetZoneContext(context.Background(), zoneID)
+}
+
+// GetZoneContext returns a zone with given identifier, with given context.
+func (c *CloudflareClient) GetZoneContext(ctx context.Context, zoneID string) (response ResponseZone, err error) {
+	var bytes []byte
+	bytes, err = c.get(ctx, fmt.Sprintf("zones/%s", zoneID), nil)
+
+	if err == nil {
+		err = json.Unmarshal(bytes, &response)
+	}
+
+	return response, err
+}
+
+// CreateZone creates a zone with given parameters.
+//
+// Generate parameters with `NewZoneCreation` function.
+//
+// https://developers.cloudflare.com/api/operations/zones-post
+func (c *CloudflareClient) CreateZone(params ZoneCreation) (response ResponseZone, err error) {
+	return c.CreateZoneContext(context.Background(), params)
+}
+
+// CreateZoneContext creates a zone with given parameters, with given context.
+func (c *CloudflareClient) CreateZoneContext(ctx context.Context, params ZoneCreation) (response ResponseZone, err error) {
+	var bytes []byte
+	bytes, err = c.post(ctx, "zones", params)
+
+	if err == nil {
+		c.InvalidateZoneCache()
+
+		err = json.Unmarshal(bytes, &response)
+	}
+
+	return response, err
+}
+
+// DeleteZone deletes a zone with given identifier.
+//
+// https://developers.cloudflare.com/api/operations/zones-0-delete
+func (c *CloudflareClient) DeleteZone(zoneID string) (response ResponseZoneID, err error) {
+	return c.DeleteZoneContext(context.Background(), zoneID)
+}
+
+// DeleteZoneContext deletes a zone with given identifier, with given context.
+func (c *CloudflareClient) DeleteZoneContext(ctx context.Context, zoneID string) (response ResponseZoneID, err error) {
+	var bytes []byte
+	bytes, err = c.delete(ctx, fmt.Sprintf("zones/%s", zoneID), nil)
+
+	if err == nil {
+		c.InvalidateZoneCache()
+
+		err = json.Unmarshal(bytes, &response)
+	}
+
+	return response, err
+}
+
+// EditZone edits a zone with given parameters. (eg. paused, plan, type, or vanity name servers)
+//
+// Generate parameters with `NewZoneEdit` function.
+//
+// https://developers.cloudflare.com/api/operations/zones-0-patch
+func (c *CloudflareClient) EditZone(zoneID string, params *ZoneEdit) (response ResponseZone, err error) {
+	return c.EditZoneContext(context.Background(), zoneID, params)
+}
+
+// EditZoneContext edits a zone with given parameters, with given context.
+func (c *CloudflareClient) EditZoneContext(ctx context.Context, zoneID string, params *ZoneEdit) (response ResponseZone, err error) {
+	var bytes []byte
+	bytes, err = c.patch(ctx, fmt.Sprintf("zones/%s", zoneID), params)
+
+	if err == nil {
+		c.InvalidateZoneCache()
+
+		err = json.Unmarshal(bytes, &response)
+	}
+
+	return response, err
+}
+
+// PauseZone pauses (or unpauses) Cloudflare services on a zone, serving DNS only.
+func (c *CloudflareClient) PauseZone(zoneID string, paused bool) (response ResponseZone, err error) {
+	return c.PauseZoneContext(context.Background(), zoneID, paused)
+}
+
+// PauseZoneContext pauses (or unpauses) Cloudflare services on a zone, with given context.
+func (c *CloudflareClient) PauseZoneContext(ctx context.Context, zoneID string, paused bool) (response ResponseZone, err error) {
+	return c.EditZoneContext(ctx, zoneID, NewZoneEdit().SetPaused(paused))
+}
+
+// TriggerActivationCheck triggers a new activation check for a pending zone.
+//
+// https://developers.cloudflare.com/api/operations/put-zones-zone_id-activation_check
+func (c *CloudflareClient) TriggerActivationCheck(zoneID string) (response ResponseZoneID, err error) {
+	return c.TriggerActivationCheckContext(context.Background(), zoneID)
+}
+
+// TriggerActivationCheckContext triggers a new activation check for a pending zone, with given context.
+func (c *CloudflareClient) TriggerActivationCheckContext(ctx context.Context, zoneID string) (response ResponseZoneID, err error) {
+	var bytes []byte
+	bytes, err = c.put(ctx, fmt.Sprintf("zones/%s/activation_check", zoneID), nil)
+
+	if err == nil {
+		c.InvalidateZoneCache()
+
+		err = json.Unmarshal(bytes, &response)
+	}
+
+	return response, err
+}
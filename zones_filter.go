@@ -0,0 +1,97 @@
+package cfgo
+
+import (
+	"net/url"
+)
+
+// prefixes of zone filter values, by string operators (none for `FilterExact`)
+var zoneFilterOperators = map[StringOperator]string{
+	FilterContains:   "contains",
+	FilterStartsWith: "starts_with",
+	FilterEndsWith:   "ends_with",
+}
+
+// ZoneFilter is a typed builder of queries for listing zones.
+//
+// https://developers.cloudflare.com/api/operations/zones-get
+type ZoneFilter struct {
+	values url.Values
+}
+
+// NewZoneFilter returns a new, empty filter.
+func NewZoneFilter() *ZoneFilter {
+	return &ZoneFilter{
+		values: url.Values{},
+	}
+}
+
+// returns given value with the operator prefix (eg. `contains:example`)
+func withOperator(op StringOperator, value string) string {
+	if prefix, exists := zoneFilterOperators[op]; exists {
+		return prefix + ":" + value
+	}
+	return value
+}
+
+// Name filters zones by their domain names. (eg. `name=ends_with:.com`)
+func (f *ZoneFilter) Name(op StringOperator, value string) *ZoneFilter {
+	f.values.Set("name", withOperator(op, value))
+	return f
+}
+
+// Status filters zones by their statuses.
+func (f *ZoneFilter) Status(status ZoneStatus) *ZoneFilter {
+	f.values.Set("status", string(status))
+	return f
+}
+
+// AccountID filters zones by their account identifiers.
+func (f *ZoneFilter) AccountID(accountID string) *ZoneFilter {
+	f.values.Set("account.id", accountID)
+	return f
+}
+
+// AccountName filters zones by their account names.
+func (f *ZoneFilter) AccountName(op StringOperator, value string) *ZoneFilter {
+	f.values.Set("account.name", withOperator(op, value))
+	return f
+}
+
+// Match sets how the filters are combined. (all: AND, any: OR)
+func (f *ZoneFilter) Match(mode MatchMode) *ZoneFilter {
+	f.values.Set("match", string(mode))
+	return f
+}
+
+// Values returns the filter as query values.
+func (f *ZoneFilter) Values() url.Values {
+	return copyValues(f.values)
+}
+
+// Queries returns the filter as queries for listing zones.
+func (f *ZoneFilter) Queries() map[string]any {
+	return valuesToQueries(f.values)
+}
+
+// String returns the encoded query string of the filter.
+func (f *ZoneFilter) String() string {
+	return f.values.Encode()
+}
+
+// merges queries of given filters
+func zoneFilterQueries(filters []*ZoneFilter) map[string]any {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	queries := map[string]any{}
+	for _, filter := range filters {
+		if filter == nil {
+			continue
+		}
+		for k, v := range filter.Queries() {
+			queries[k] = v
+		}
+	}
+	return queries
+}
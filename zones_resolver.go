@@ -0,0 +1,208 @@
+package cfgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	zoneCacheTTLSeconds = 300
+)
+
+// ErrZoneNotFound is returned when no zone owns a given domain name.
+//
+// `IsNotFound` also returns true for this error.
+var ErrZoneNotFound = errors.New("no zone found")
+
+// zone identifiers are 32 hexadecimal characters
+var zoneIDRegex = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// in-process cache of listed zones, for resolving zones by domain names
+type zoneCache struct {
+	sync.Mutex
+
+	zones     []Zone
+	fetchedAt time.Time
+
+	names map[string]string // names of zones by their identifiers (kept on invalidation, as they do not change)
+}
+
+// ResolveZone returns the zone which owns given domain name (eg. `www.example.com`), by the longest suffix match.
+//
+// Zones are listed once and cached in the client for a while. (misses are also cached until then)
+func (c *CloudflareClient) ResolveZone(name string) (zone Zone, err error) {
+	return c.ResolveZoneContext(context.Background(), name)
+}
+
+// ResolveZoneContext returns the zone which owns given domain name, with given context.
+func (c *CloudflareClient) ResolveZoneContext(ctx context.Context, name string) (zone Zone, err error) {
+	return c.resolveZone(ctx, name, false)
+}
+
+// ResolveZoneByName returns the zone with exactly the given name. (eg. `example.com`, but not `www.example.com`)
+//
+// Use this instead of `ResolveZone` for zone-level changes, so that subdomains or typos would not resolve to their parent zones.
+func (c *CloudflareClient) ResolveZoneByName(name string) (zone Zone, err error) {
+	return c.ResolveZoneByNameContext(context.Background(), name)
+}
+
+// ResolveZoneByNameContext returns the zone with exactly the given name, with given context.
+func (c *CloudflareClient) ResolveZoneByNameContext(ctx context.Context, name string) (zone Zone, err error) {
+	return c.resolveZone(ctx, name, true)
+}
+
+// resolves a zone from the cached zones, with an exact or the longest suffix match
+func (c *CloudflareClient) resolveZone(ctx context.Context, name string, exact bool) (zone Zone, err error) {
+	name = normalizeDomainName(name)
+	if name == "" {
+		return zone, fmt.Errorf("%w: empty domain name", ErrZoneNotFound)
+	}
+
+	c.zoneCache.Lock()
+	defer c.zoneCache.Unlock()
+
+	if c.zoneCache.zones == nil || time.Since(c.zoneCache.fetchedAt) > zoneCacheTTLSeconds*time.Second {
+		if err = c.refreshZoneCache(ctx); err != nil {
+			return zone, err
+		}
+	}
+
+	var found bool
+	if zone, found = bestMatch(c.zoneCache.zones, name, exact); !found {
+		return zone, fmt.Errorf("%w for '%s'", ErrZoneNotFound, name)
+	}
+
+	return zone, nil
+}
+
+// IsZoneID returns whether given value is in the format of zone identifiers. (32 hexadecimal characters)
+func IsZoneID(value string) bool {
+	return zoneIDRegex.MatchString(value)
+}
+
+// ResolveZoneID returns given value as it is if it is a zone identifier,
+// or the identifier of the zone which owns it if it is a domain name.
+func (c *CloudflareClient) ResolveZoneID(zoneIDOrName string) (zoneID string, err error) {
+	return c.ResolveZoneIDContext(context.Background(), zoneIDOrName)
+}
+
+// ResolveZoneIDContext returns given value as it is if it is a zone identifier,
+// or the identifier of the zone which owns it if it is a domain name, with given context.
+func (c *CloudflareClient) ResolveZoneIDContext(ctx context.Context, zoneIDOrName string) (zoneID string, err error) {
+	if IsZoneID(zoneIDOrName) {
+		return zoneIDOrName, nil
+	}
+
+	var zone Zone
+	if zone, err = c.ResolveZoneContext(ctx, zoneIDOrName); err == nil {
+		zoneID = zone.ID
+	}
+
+	return zoneID, err
+}
+
+// InvalidateZoneCache clears the cached zones, so that they will be listed again on the next resolution.
+//
+// It is called automatically when zones are created, edited, checked for activation, or deleted with this client.
+func (c *CloudflareClient) InvalidateZoneCache() {
+	c.zoneCache.Lock()
+	defer c.zoneCache.Unlock()
+
+	c.zoneCache.zones = nil
+}
+
+// lists all zones into the cache (should be called with the lock held)
+func (c *CloudflareClient) refreshZoneCache(ctx context.Context) error {
+	zones, err := c.ListAllZonesContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list zones: %w", err)
+	}
+
+	if zones == nil {
+		zones = []Zone{}
+	}
+	c.zoneCache.zones = zones
+	c.zoneCache.fetchedAt = time.Now()
+
+	for _, zone := range zones {
+		c.cacheZoneName(zone)
+	}
+
+	return nil
+}
+
+// returns the name of the zone with given identifier, from the cache or by fetching the zone
+func (c *CloudflareClient) zoneName(ctx context.Context, zoneID string) (name string, err error) {
+	c.zoneCache.Lock()
+	defer c.zoneCache.Unlock()
+
+	if name, exists := c.zoneCache.names[zoneID]; exists {
+		return name, nil
+	}
+
+	var fetched ResponseZone
+	if fetched, err = c.GetZoneContext(ctx, zoneID); err != nil {
+		return "", fmt.Errorf("failed to get zone: %w", err)
+	}
+	c.cacheZoneName(fetched.Result)
+
+	return normalizeDomainName(fetched.Result.Name), nil
+}
+
+// caches the name of given zone (should be called with the lock held)
+func (c *CloudflareClient) cacheZoneName(zone Zone) {
+	if zone.ID == "" || zone.Name == "" {
+		return
+	}
+
+	if c.zoneCache.names == nil {
+		c.zoneCache.names = map[string]string{}
+	}
+	c.zoneCache.names[zone.ID] = normalizeDomainName(zone.Name)
+}
+
+// returns given record name qualified with given zone name (eg. `@` => `example.com`, `www` => `www.example.com`)
+//
+// names which are already in the zone are returned as they are (but normalized).
+func qualifiedName(name, zoneName string) string {
+	name = normalizeDomainName(name)
+	if name == "@" || name == "" {
+		return zoneName
+	}
+	if name == zoneName || strings.HasSuffix(name, "."+zoneName) {
+		return name
+	}
+
+	return name + "." + zoneName
+}
+
+// returns the zone with the longest name which equals to, or (when not exact) is a parent domain of given name
+//
+// active zones are preferred over others with the same name
+func bestMatch(zones []Zone, name string, exact bool) (matched Zone, found bool) {
+	for _, zone := range zones {
+		zoneName := normalizeDomainName(zone.Name)
+		if zoneName == "" || (name != zoneName && (exact || !strings.HasSuffix(name, "."+zoneName))) {
+			continue
+		}
+
+		matchedName := normalizeDomainName(matched.Name)
+		if !found ||
+			len(zoneName) > len(matchedName) ||
+			(len(zoneName) == len(matchedName) && matched.Status != ZoneActive && zone.Status == ZoneActive) {
+			matched, found = zone, true
+		}
+	}
+
+	return matched, found
+}
+
+// returns given domain name in lower case, without surrounding spaces and a trailing dot
+func normalizeDomainName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
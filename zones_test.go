@@ -0,0 +1,152 @@
+package cfgo
+
+import (
+	"testing"
+
+	"github.com/meinside/cloudflare-go/cfgotest"
+)
+
+func TestZoneLifecycle(t *testing.T) {
+	server := cfgotest.NewServer()
+	defer server.Close()
+
+	server.AddZone("existing.com")
+	client := NewCloudflareClientWithAPIToken("test-token", WithBaseURL(server.BaseURL()))
+
+	// create
+	params := NewZoneCreation("0123456789abcdef0123456789abcdef", "example.com")
+	params.JumpStart = true
+	created, err := client.CreateZone(params)
+	if err != nil {
+		t.Fatalf("failed to create zone: %s", err)
+	}
+	zoneID := created.Result.ID
+	if created.Result.Name != "example.com" || created.Result.Status != ZonePending || created.Result.Type != ZoneFull {
+		t.Errorf("unexpected created zone: %+v", created.Result)
+	}
+	if _, err := client.CreateZone(params); err == nil {
+		t.Errorf("should fail with a duplicated zone")
+	}
+
+	// list with filters
+	if zones, err := client.ListAllZones(NewZoneFilter().Name(FilterEndsWith, "example.com").Status(ZonePending)); err == nil {
+		if len(zones) != 1 || zones[0].ID != zoneID {
+			t.Errorf("expected only the created zone, but got %+v", zones)
+		}
+	} else {
+		t.Errorf("failed to list zones: %s", err)
+	}
+	if zones, err := client.ListZones(); err != nil || len(zones.Result) != 2 {
+		t.Errorf("expected 2 zones, but got %d (%v)", len(zones.Result), err)
+	}
+
+	// activation check
+	if _, err := client.TriggerActivationCheck(zoneID); err != nil {
+		t.Errorf("failed to trigger activation check: %s", err)
+	}
+	if zone, err := client.GetZone(zoneID); err != nil || zone.Result.Status != ZoneActive {
+		t.Errorf("expected an active zone, but got %+v (%v)", zone.Result, err)
+	}
+
+	// edit
+	if paused, err := client.PauseZone(zoneID, true); err != nil || !paused.Result.Paused {
+		t.Errorf("expected a paused zone, but got %+v (%v)", paused.Result, err)
+	}
+	if edited, err := client.EditZone(zoneID, NewZoneEdit().SetVanityNameServers([]string{"ns1.example.com", "ns2.example.com"})); err != nil || len(edited.Result.VanityNameServers) != 2 {
+		t.Errorf("expected vanity name servers, but got %+v (%v)", edited.Result, err)
+	}
+
+	// delete
+	if deleted, err := client.DeleteZone(zoneID); err != nil || deleted.Result.ID != zoneID {
+		t.Errorf("failed to delete zone: %+v (%v)", deleted, err)
+	}
+	if _, err := client.GetZone(zoneID); !IsNotFound(err) {
+		t.Errorf("should fail with a deleted zone, but got: %v", err)
+	}
+}
+
+func TestZoneFilter(t *testing.T) {
+	filter := NewZoneFilter().
+		Name(FilterContains, "example").
+		AccountName(FilterExact, "My Account").
+		AccountID("account-id").
+		Status(ZoneActive).
+		Match(MatchAnyConditions)
+
+	if expected := "account.id=account-id&account.name=My+Account&match=any&name=contains%3Aexample&status=active"; filter.String() != expected {
+		t.Errorf("expected '%s', but got '%s'", expected, filter.String())
+	}
+}
+
+func TestResolveZone(t *testing.T) {
+	server := cfgotest.NewServer()
+	defer server.Close()
+
+	parentID := server.AddZone("example.com")
+	childID := server.AddZone("dev.example.com")
+	client := NewCloudflareClientWithAPIToken("test-token", WithBaseURL(server.BaseURL()))
+
+	// longest suffix match
+	for name, expected := range map[string]string{
+		"example.com":          parentID,
+		"WWW.Example.com.":     parentID,
+		"dev.example.com":      childID,
+		"api.dev.example.com":  childID,
+		"api.devexample.com":   "",
+		"example.com.attacker": "",
+	} {
+		zone, err := client.ResolveZone(name)
+		if expected == "" {
+			if !IsNotFound(err) {
+				t.Errorf("expected no zone for '%s', but got %+v (%v)", name, zone, err)
+			}
+		} else if err != nil || zone.ID != expected {
+			t.Errorf("expected zone '%s' for '%s', but got %+v (%v)", expected, name, zone, err)
+		}
+	}
+
+	// zone identifiers are returned as they are, without requests
+	requests := server.Requests()
+	if zoneID, err := client.ResolveZoneID(childID); err != nil || zoneID != childID {
+		t.Errorf("expected zone id '%s', but got '%s' (%v)", childID, zoneID, err)
+	}
+
+	// cached zones are reused, for misses too
+	if zoneID, err := client.ResolveZoneID("www.example.com"); err != nil || zoneID != parentID {
+		t.Errorf("expected zone id '%s', but got '%s' (%v)", parentID, zoneID, err)
+	}
+	for range 3 {
+		if _, err := client.ResolveZoneID("unknown.com"); !IsNotFound(err) {
+			t.Errorf("expected a not found error, but got %v", err)
+		}
+	}
+	if server.Requests() != requests {
+		t.Errorf("expected no more requests, but got %d", server.Requests()-requests)
+	}
+
+	// exact match only
+	if zone, err := client.ResolveZoneByName("Dev.Example.com."); err != nil || zone.ID != childID {
+		t.Errorf("expected zone '%s', but got %+v (%v)", childID, zone, err)
+	}
+	for _, name := range []string{"www.example.com", "exmple.example.com"} {
+		if zone, err := client.ResolveZoneByName(name); !IsNotFound(err) {
+			t.Errorf("expected no zone for '%s', but got %+v (%v)", name, zone, err)
+		}
+	}
+
+	// cache is invalidated on edits
+	if _, err := client.PauseZone(parentID, true); err != nil {
+		t.Fatalf("failed to pause zone: %s", err)
+	}
+	if zone, err := client.ResolveZone("example.com"); err != nil || !zone.Paused {
+		t.Errorf("expected a paused zone, but got %+v (%v)", zone, err)
+	}
+
+	// cache is invalidated on deletion
+	if _, err := client.DeleteZone(childID); err != nil {
+		t.Fatalf("failed to delete zone: %s", err)
+	}
+	if zoneID, err := client.ResolveZoneID("api.dev.example.com"); err != nil || zoneID != parentID {
+		t.Errorf("expected zone id '%s' after deletion, but got '%s' (%v)", parentID, zoneID, err)
+	}
+}
@@ -0,0 +1,172 @@
+package cfgo
+
+// ZoneStatus for the status of zones
+type ZoneStatus string
+
+const (
+	ZoneInitializing ZoneStatus = "initializing"
+	ZonePending      ZoneStatus = "pending"
+	ZoneActive       ZoneStatus = "active"
+	ZoneMoved        ZoneStatus = "moved"
+)
+
+// ZoneType for the type of zones
+//
+// https://developers.cloudflare.com/dns/zone-setups/
+type ZoneType string
+
+const (
+	ZoneFull      ZoneType = "full"
+	ZonePartial   ZoneType = "partial"
+	ZoneSecondary ZoneType = "secondary"
+)
+
+// ZoneAccount struct for the account of zones
+type ZoneAccount struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// Zone struct for all the zones
+type Zone struct {
+	Name            string      `json:"name"`
+	Account         ZoneAccount `json:"account"`
+	CreatedOn       string      `json:"created_on"`
+	ActivatedOn     string      `json:"activated_on"`
+	ModifiedOn      string      `json:"modified_on"`
+	DevelopmentMode int         `json:"development_mode"`
+	ID              string      `json:"id"`
+	Meta            struct {
+		CustomCertificateQuoti  int  `json:"custom_certificate_quota"`
+		MultipleRailgunsAllowed bool `json:"multiple_railguns_allowed"`
+		PageRuleQuota           int  `json:"page_rule_quota"`
+		PhishingDetected        bool `json:"phishing_detected"`
+		Step                    int  `json:"step"`
+	} `json:"meta"`
+	NameServers         []string `json:"name_servers"`
+	OriginalDNSHost     string   `json:"original_dnshost"`
+	OriginalNameServers []string `json:"original_name_servers"`
+	OriginalRegistrar   string   `json:"original_registrar"`
+	Owner               struct {
+		Email string `json:"email"`
+		ID    string `json:"id"`
+		Type  string `json:"type"`
+	} `json:"owner"`
+	Paused      bool     `json:"paused"`
+	Permissions []string `json:"permissions"`
+	Plan        struct {
+		CanSubscribe      bool   `json:"can_subscribe"`
+		Currency          string `json:"currency"`
+		ExternallyManaged bool   `json:"externally_managed"`
+		Frequency         string `json:"frequency"`
+		ID                string `json:"id"`
+		IsSubscribed      bool   `json:"is_subscribed"`
+		LegacyDiscount    bool   `json:"legacy_discount"`
+		LegacyID          string `json:"legacy_id"`
+		Name              string `json:"name"`
+		Price             int    `json:"price"`
+	} `json:"plan"`
+	Status ZoneStatus `json:"status"`
+	Tenant struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"tenant"`
+	TenantUnit struct {
+		ID string `json:"id"`
+	} `json:"tenant_unit"`
+	Type              ZoneType `json:"type"`
+	VanityNameServers []string `json:"vanity_name_servers,omitempty"`
+}
+
+// ResponseZones for zones response
+type ResponseZones struct {
+	ResponseCommon
+
+	Result     []Zone     `json:"result"`
+	ResultInfo ResultInfo `json:"result_info,omitempty"`
+}
+
+// ResponseZone struct for the responses of `GetZone`, `CreateZone`, and `EditZone` functions
+type ResponseZone struct {
+	ResponseCommon
+
+	Result Zone `json:"result"`
+}
+
+// ResponseZoneID struct for the responses of `DeleteZone` and `TriggerActivationCheck` functions
+type ResponseZoneID struct {
+	ResponseCommon
+
+	Result struct {
+		ID string `json:"id"`
+	} `json:"result"`
+}
+
+// ZoneCreation struct for the parameters of `CreateZone` function
+//
+// https://developers.cloudflare.com/api/operations/zones-post
+type ZoneCreation struct {
+	Account   ZoneAccount `json:"account"`
+	Name      string      `json:"name"`
+	JumpStart bool        `json:"jump_start,omitempty"` // automatically scan for existing DNS records
+	Type      ZoneType    `json:"type,omitempty"`
+}
+
+// NewZoneCreation returns the parameters for creating a full zone with given account identifier and domain name.
+func NewZoneCreation(accountID, name string) ZoneCreation {
+	return ZoneCreation{
+		Account: ZoneAccount{
+			ID: accountID,
+		},
+		Name: name,
+		Type: ZoneFull,
+	}
+}
+
+// ZoneEdit struct for the parameters of `EditZone` function
+//
+// Only one property can be changed at a time.
+//
+// https://developers.cloudflare.com/api/operations/zones-0-patch
+type ZoneEdit struct {
+	Paused            *bool         `json:"paused,omitempty"`
+	Plan              *ZoneEditPlan `json:"plan,omitempty"`
+	Type              ZoneType      `json:"type,omitempty"`
+	VanityNameServers []string      `json:"vanity_name_servers,omitempty"`
+}
+
+// ZoneEditPlan struct for the plan of `ZoneEdit`
+type ZoneEditPlan struct {
+	ID string `json:"id"`
+}
+
+// NewZoneEdit returns new, empty parameters for editing a zone.
+func NewZoneEdit() *ZoneEdit {
+	return &ZoneEdit{}
+}
+
+// SetPaused sets the `paused` value of zone.
+func (e *ZoneEdit) SetPaused(paused bool) *ZoneEdit {
+	e.Paused = &paused
+	return e
+}
+
+// SetPlanID sets the plan of zone with its identifier.
+func (e *ZoneEdit) SetPlanID(planID string) *ZoneEdit {
+	e.Plan = &ZoneEditPlan{
+		ID: planID,
+	}
+	return e
+}
+
+// SetType sets the type of zone.
+func (e *ZoneEdit) SetType(typ3 ZoneType) *ZoneEdit {
+	e.Type = typ3
+	return e
+}
+
+// SetVanityNameServers sets the vanity name servers of zone. (only for business and enterprise plans)
+func (e *ZoneEdit) SetVanityNameServers(nameServers []string) *ZoneEdit {
+	e.VanityNameServers = nameServers
+	return e
+}